@@ -19,11 +19,10 @@ type (
 	// Span combines an interval with a count. Where Qty is intended to only be used to
 	// create an Interval (hence the small data type), Span is intended to represent one
 	// or more of those intervals in a series of any length.
-	// FIXME: re-enable once Unit is rolled out.
-	// Span struct {
-	//     Interval
-	//     Num int64
-	// }
+	Span struct {
+		Interval
+		Num int64
+	}
 
 	// Moment combines Period and Interval as a complete representation of a specific
 	// moment of UTC time.
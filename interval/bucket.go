@@ -0,0 +1,230 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Bucket summarises the samples added to a Bucketer for a single Period.
+type Bucket struct {
+	Period Period
+	Count  int64
+	Sum    float64
+	Min    float64
+	Max    float64
+
+	// Last is the value of the sample with the latest time. If several samples
+	// share the latest time, the one that was added last wins.
+	Last float64
+
+	lastAt time.Time
+}
+
+func (b Bucket) IsEmpty() bool { return b.Count == 0 }
+
+// Mean returns the arithmetic mean of the samples in the Bucket, or NaN if the
+// Bucket is empty.
+func (b Bucket) Mean() float64 {
+	if b.Count == 0 {
+		return math.NaN()
+	}
+	return b.Sum / float64(b.Count)
+}
+
+func (b *Bucket) add(t time.Time, v float64) {
+	if b.Count == 0 {
+		b.Min, b.Max, b.Last, b.lastAt = v, v, v, t
+	} else {
+		if v < b.Min {
+			b.Min = v
+		}
+		if v > b.Max {
+			b.Max = v
+		}
+		if !t.Before(b.lastAt) {
+			b.Last, b.lastAt = v, t
+		}
+	}
+	b.Count++
+	b.Sum += v
+}
+
+func (b *Bucket) merge(o Bucket) {
+	if o.Count == 0 {
+		return
+	}
+	if b.Count == 0 {
+		p := b.Period
+		*b = o
+		b.Period = p
+		return
+	}
+	if o.Min < b.Min {
+		b.Min = o.Min
+	}
+	if o.Max > b.Max {
+		b.Max = o.Max
+	}
+	if !o.lastAt.Before(b.lastAt) {
+		b.Last, b.lastAt = o.Last, o.lastAt
+	}
+	b.Count += o.Count
+	b.Sum += o.Sum
+}
+
+// GapFill controls the values used for the empty Buckets returned by
+// Bucketer.Fill.
+type GapFill int
+
+const (
+	// GapZero fills gaps with Buckets where every value is zero.
+	GapZero GapFill = iota
+
+	// GapNaN fills gaps with Buckets where Min, Max and Last are NaN. Sum is
+	// still zero.
+	GapNaN
+
+	// GapPrevious fills gaps with Buckets where Min, Max and Last are all set
+	// to the Last value of the closest preceding non-empty Bucket. Gaps before
+	// the first non-empty Bucket are filled as per GapNaN.
+	GapPrevious
+)
+
+// Bucketer accumulates (time.Time, float64) samples into a Bucket per Period
+// of an Interval.
+//
+// Bucketer is not safe for concurrent use.
+type Bucketer struct {
+	intvl   Interval
+	buckets map[Period]*Bucket
+}
+
+func NewBucketer(intvl Interval) *Bucketer {
+	return &Bucketer{
+		intvl:   intvl,
+		buckets: make(map[Period]*Bucket),
+	}
+}
+
+func (b *Bucketer) Interval() Interval { return b.intvl }
+
+// Len returns the number of non-empty Buckets.
+func (b *Bucketer) Len() int { return len(b.buckets) }
+
+// Add adds a sample to the Bucket for the Period that contains t.
+func (b *Bucketer) Add(t time.Time, v float64) {
+	p := b.intvl.Period(t)
+	bucket := b.buckets[p]
+	if bucket == nil {
+		bucket = &Bucket{Period: p}
+		b.buckets[p] = bucket
+	}
+	bucket.add(t, v)
+}
+
+// Bucket returns the Bucket for Period p. If no samples have been added for p,
+// ok is false.
+func (b *Bucketer) Bucket(p Period) (bucket Bucket, ok bool) {
+	bp := b.buckets[p]
+	if bp == nil {
+		return Bucket{Period: p}, false
+	}
+	return *bp, true
+}
+
+// Range returns the smallest Range that contains all of the non-empty Buckets.
+// If the Bucketer is empty, an empty Range is returned.
+func (b *Bucketer) Range() Range {
+	rng := Range{Interval: b.intvl}
+	first := true
+	for p := range b.buckets {
+		if first || p < rng.Since {
+			rng.Since = p
+		}
+		if first || p+1 > rng.Until {
+			rng.Until = p + 1
+		}
+		first = false
+	}
+	return rng
+}
+
+// Buckets returns all non-empty Buckets, sorted by Period.
+func (b *Bucketer) Buckets() []Bucket {
+	out := make([]Bucket, 0, len(b.buckets))
+	for _, bucket := range b.buckets {
+		out = append(out, *bucket)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Period < out[j].Period })
+	return out
+}
+
+// Fill returns one Bucket for every Period in rng, in order. Periods that do
+// not have any samples are filled according to gap. The Range must have the
+// same Interval as the Bucketer.
+func (b *Bucketer) Fill(rng Range, gap GapFill) ([]Bucket, error) {
+	if rng.Interval != b.intvl {
+		return nil, fmt.Errorf("interval: cannot fill %s bucketer using %s range", b.intvl, rng.Interval)
+	}
+
+	fill := math.NaN()
+	if gap == GapPrevious {
+		// Find the closest non-empty bucket before the start of the range so
+		// the first gap can be filled:
+		var found bool
+		var foundPeriod Period
+		for p := range b.buckets {
+			if p < rng.Since && (!found || p > foundPeriod) {
+				found, foundPeriod = true, p
+			}
+		}
+		if found {
+			fill = b.buckets[foundPeriod].Last
+		}
+	}
+
+	out := make([]Bucket, 0, rng.Len())
+	for p := rng.Since; p < rng.Until; p++ {
+		if bucket := b.buckets[p]; bucket != nil {
+			out = append(out, *bucket)
+			fill = bucket.Last
+			continue
+		}
+
+		bucket := Bucket{Period: p}
+		switch gap {
+		case GapNaN:
+			bucket.Min, bucket.Max, bucket.Last = math.NaN(), math.NaN(), math.NaN()
+		case GapPrevious:
+			bucket.Min, bucket.Max, bucket.Last = fill, fill, fill
+		}
+		out = append(out, bucket)
+	}
+	return out, nil
+}
+
+// Rollup merges the Buckets into a new Bucketer with a coarser Interval. The
+// receiver's Interval must combine cleanly into 'to' (see
+// Interval.CanCombineTo), otherwise samples would be split across the coarse
+// Buckets incorrectly.
+//
+// Rolling up to the same Interval returns a copy.
+func (b *Bucketer) Rollup(to Interval) (*Bucketer, error) {
+	if to != b.intvl && !b.intvl.CanCombineTo(to) {
+		return nil, fmt.Errorf("interval: %s does not combine cleanly into %s", b.intvl, to)
+	}
+
+	out := NewBucketer(to)
+	for p, bucket := range b.buckets {
+		toPeriod := to.Period(b.intvl.Time(p, nil))
+		toBucket := out.buckets[toPeriod]
+		if toBucket == nil {
+			toBucket = &Bucket{Period: toPeriod}
+			out.buckets[toPeriod] = toBucket
+		}
+		toBucket.merge(*bucket)
+	}
+	return out, nil
+}
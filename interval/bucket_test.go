@@ -0,0 +1,138 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBucketerAdd(t *testing.T) {
+	b := NewBucketer(Of1Minute)
+	b.Add(tm("2020-01-01T00:00:10Z"), 3)
+	b.Add(tm("2020-01-01T00:00:50Z"), 1)
+	b.Add(tm("2020-01-01T00:00:20Z"), 5)
+	b.Add(tm("2020-01-01T00:02:00Z"), 7)
+
+	if b.Len() != 2 {
+		t.Fatal(b.Len())
+	}
+
+	p := Of1Minute.Period(tm("2020-01-01T00:00:00Z"))
+	bucket, ok := b.Bucket(p)
+	if !ok {
+		t.Fatal()
+	}
+	if bucket.Count != 3 || bucket.Sum != 9 || bucket.Min != 1 || bucket.Max != 5 || bucket.Mean() != 3 {
+		t.Fatal(bucket)
+	}
+
+	// Last is by sample time, not by insertion order:
+	if bucket.Last != 1 {
+		t.Fatal(bucket.Last)
+	}
+
+	if _, ok := b.Bucket(p + 1); ok {
+		t.Fatal()
+	}
+
+	rng := b.Range()
+	if rng != (Range{Of1Minute, p, p + 3}) {
+		t.Fatal(rng)
+	}
+
+	buckets := b.Buckets()
+	if len(buckets) != 2 || buckets[0].Period != p || buckets[1].Period != p+2 {
+		t.Fatal(buckets)
+	}
+}
+
+func TestBucketerFill(t *testing.T) {
+	b := NewBucketer(Of1Minute)
+	p := Of1Minute.Period(tm("2020-01-01T00:00:00Z"))
+	b.Add(tm("2020-01-01T00:00:00Z"), 2)
+	b.Add(tm("2020-01-01T00:02:00Z"), 4)
+
+	rng := Range{Of1Minute, p - 1, p + 4}
+
+	zero, err := b.Fill(rng, GapZero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zero) != 5 {
+		t.Fatal(len(zero))
+	}
+	for i, bucket := range zero {
+		if bucket.Period != rng.Since+Period(i) {
+			t.Fatal(i, bucket.Period)
+		}
+	}
+	if !zero[0].IsEmpty() || zero[0].Last != 0 || zero[1].Last != 2 || !zero[2].IsEmpty() || zero[3].Last != 4 {
+		t.Fatal(zero)
+	}
+
+	nan, err := b.Fill(rng, GapNaN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(nan[0].Last) || !math.IsNaN(nan[2].Min) || nan[2].Sum != 0 || nan[3].Last != 4 {
+		t.Fatal(nan)
+	}
+
+	prev, err := b.Fill(rng, GapPrevious)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(prev[0].Last) || prev[2].Last != 2 || prev[2].Max != 2 || prev[4].Last != 4 || prev[4].Count != 0 {
+		t.Fatal(prev)
+	}
+
+	// GapPrevious should find the last bucket before the range:
+	prev, err = b.Fill(Range{Of1Minute, p + 1, p + 2}, GapPrevious)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prev) != 1 || prev[0].Last != 2 {
+		t.Fatal(prev)
+	}
+
+	if _, err := b.Fill(Range{Of1Hour, 0, 1}, GapZero); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestBucketerRollup(t *testing.T) {
+	b := NewBucketer(Of15Minutes)
+	b.Add(tm("2020-01-01T00:00:00Z"), 1)
+	b.Add(tm("2020-01-01T00:20:00Z"), 8)
+	b.Add(tm("2020-01-01T00:59:00Z"), 3)
+	b.Add(tm("2020-01-01T01:10:00Z"), -2)
+
+	hourly, err := b.Rollup(Of1Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hourly.Interval() != Of1Hour || hourly.Len() != 2 {
+		t.Fatal(hourly.Interval(), hourly.Len())
+	}
+
+	p := Of1Hour.Period(tm("2020-01-01T00:00:00Z"))
+	bucket, _ := hourly.Bucket(p)
+	if bucket.Period != p || bucket.Count != 3 || bucket.Sum != 12 || bucket.Min != 1 || bucket.Max != 8 || bucket.Last != 3 {
+		t.Fatal(bucket)
+	}
+	bucket, _ = hourly.Bucket(p + 1)
+	if bucket.Count != 1 || bucket.Last != -2 {
+		t.Fatal(bucket)
+	}
+
+	if _, err := b.Rollup(Raw(7, Minutes)); err == nil {
+		t.Fatal()
+	}
+	if _, err := b.Rollup(Of1Day); err == nil {
+		t.Fatal()
+	}
+
+	same, err := b.Rollup(Of15Minutes)
+	if err != nil || same.Len() != b.Len() {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,151 @@
+package interval
+
+import (
+	"sort"
+	"time"
+)
+
+// meanMonth is the mean length of a month in the Gregorian calendar, which
+// repeats every 400 years (146097 days, or 4800 months). It happens to be a
+// whole number of seconds.
+const meanMonth = 146097 * 86400 / 4800 * time.Second
+
+// meanDuration returns the size of the Interval with Day as exactly 24 hours,
+// Week as exactly 7 days and Month, Quarter and Year based on meanMonth. The
+// largest valid Interval, 255 years, fits comfortably in a time.Duration.
+func (i Interval) meanDuration() time.Duration {
+	if months := unitMonths(i.Unit()); months > 0 {
+		return time.Duration(months) * time.Duration(i.Qty()) * meanMonth
+	}
+	return unitNanos(i.Unit()) * time.Duration(i.Qty())
+}
+
+// unitRank returns the position of the Unit in Units, or -1 if the Unit is
+// not valid.
+func unitRank(u Unit) int {
+	for idx, unit := range Units {
+		if unit == u {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Compare returns -1 if i is smaller than j, 1 if i is larger than j and 0
+// if i == j. Unlike Less, Compare is a total order that does not depend on a
+// reference time:
+//
+// Intervals are first compared by their mean duration in the Gregorian
+// calendar, where a Day is 24 hours, a Week is 7 Days, a Year is 365.2425 Days
+// and a Month is 1/12 of a Year. If the mean durations are equal, the Interval
+// with the smaller Unit (according to the order of Units) comes first, so
+// 24hr < 1d and 12mo < 4qtr < 1yr. Invalid Units come before all valid Units.
+//
+// This means that Compare always agrees with Less when both Intervals use
+// Units that have a fixed duration (Week or smaller), and that 24mo sorts
+// after 1d.
+func Compare(i, j Interval) int {
+	if i == j {
+		return 0
+	}
+	id, jd := i.meanDuration(), j.meanDuration()
+	if id != jd {
+		if id < jd {
+			return -1
+		}
+		return 1
+	}
+	ir, jr := unitRank(i.Unit()), unitRank(j.Unit())
+	if ir != jr {
+		if ir < jr {
+			return -1
+		}
+		return 1
+	}
+	// Only reachable for invalid Units, which have a zero duration:
+	if i < j {
+		return -1
+	}
+	return 1
+}
+
+// Compare is a method wrapper for interval.Compare.
+func (i Interval) Compare(j Interval) int { return Compare(i, j) }
+
+// Min returns the smallest Interval according to Compare, or zero if no
+// Intervals are passed.
+func Min(intvls ...Interval) (min Interval) {
+	for idx, intvl := range intvls {
+		if idx == 0 || Compare(intvl, min) < 0 {
+			min = intvl
+		}
+	}
+	return min
+}
+
+// Max returns the largest Interval according to Compare, or zero if no
+// Intervals are passed.
+func Max(intvls ...Interval) (max Interval) {
+	for idx, intvl := range intvls {
+		if idx == 0 || Compare(intvl, max) > 0 {
+			max = intvl
+		}
+	}
+	return max
+}
+
+// CompareOver compares the mean duration of the Periods of i and j that start
+// within [from, to), which can differ from Compare for calendar Units (a
+// Month in February is shorter than 30 days) and for Intervals that are
+// large relative to the range. If from is not before to, or if the mean
+// durations are equal, the result of Compare is returned.
+//
+// If an Interval has no Periods that start in the range, the Period that
+// contains from is used.
+func (i Interval) CompareOver(j Interval, from, to time.Time) int {
+	if from.Before(to) {
+		im, jm := i.meanDurationOver(from, to), j.meanDurationOver(from, to)
+		if im < jm {
+			return -1
+		} else if im > jm {
+			return 1
+		}
+	}
+	return Compare(i, j)
+}
+
+// LessOver reports whether i is smaller than j as per CompareOver.
+func (i Interval) LessOver(j Interval, from, to time.Time) bool {
+	return i.CompareOver(j, from, to) < 0
+}
+
+func (i Interval) meanDurationOver(from, to time.Time) float64 {
+	rng := NewRange(i, from, to)
+	if i.Time(rng.Since, nil).Before(from) && rng.Len() > 1 {
+		rng.Since++
+	}
+	n := rng.Len()
+	if n == 0 {
+		n = 1
+	}
+	total := i.Time(rng.Since+Period(n), nil).Sub(i.Time(rng.Since, nil))
+	return float64(total) / float64(n)
+}
+
+// Intervals attaches the methods of sort.Interface to []Interval, sorting in
+// increasing order as per Compare.
+type Intervals []Interval
+
+var _ sort.Interface = Intervals{}
+
+func (s Intervals) Len() int           { return len(s) }
+func (s Intervals) Less(i, j int) bool { return Compare(s[i], s[j]) < 0 }
+func (s Intervals) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts the Intervals as per Compare.
+func (s Intervals) Sort() { sort.Sort(s) }
+
+// SortOver sorts the Intervals as per CompareOver.
+func (s Intervals) SortOver(from, to time.Time) {
+	sort.Slice(s, func(i, j int) bool { return s[i].LessOver(s[j], from, to) })
+}
@@ -0,0 +1,136 @@
+package interval
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b Interval
+		ex   int
+	}{
+		{Of1Day, Of1Day, 0},
+		{Of1Hour, Of1Day, -1},
+		{Of1Day, Raw(24, Month), -1},
+		{Raw(24, Hour), Of1Day, -1},
+		{Raw(7, Day), Of1Week, -1},
+		{Raw(12, Month), Raw(4, Quarter), -1},
+		{Raw(4, Quarter), Of1Year, -1},
+		{Raw(3, Month), Of1Quarter, -1},
+		{Raw(4, Week), Of1Month, -1},
+		{Raw(31, Day), Of1Month, 1},
+		{Raw(30, Day), Of1Month, -1},
+		{Raw(0, Microsecond), Of1Microsecond, -1},
+		{0, Of1Microsecond, -1},
+		{Raw(60, Second), Of1Minute, -1},
+		{Raw(61, Second), Of1Minute, 1},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := Compare(tc.a, tc.b); result != tc.ex {
+				t.Fatal(tc.a, tc.b, result, "!=", tc.ex)
+			}
+			if result := tc.b.Compare(tc.a); result != -tc.ex {
+				t.Fatal(tc.b, tc.a, result, "!=", -tc.ex)
+			}
+		})
+	}
+}
+
+func TestCompareAgreesWithLess(t *testing.T) {
+	var fixed []Interval
+	for _, unit := range []Unit{Microsecond, Millisecond, Second, Minute, Hour, Day, Week} {
+		for qty := Qty(1); qty <= unit.MaxQty(); qty++ {
+			fixed = append(fixed, Raw(qty, unit))
+		}
+	}
+	for _, a := range fixed {
+		for _, b := range fixed {
+			if a.Less(b) && Compare(a, b) >= 0 {
+				t.Fatal(a, b)
+			}
+		}
+	}
+}
+
+func TestCompareTotalOrder(t *testing.T) {
+	var all []Interval
+	for _, unit := range Units {
+		for qty := Qty(1); qty <= unit.MaxQty(); qty++ {
+			all = append(all, Raw(qty, unit))
+		}
+	}
+
+	sorted := append(Intervals{}, all...)
+	sorted.Sort()
+	if !sort.IsSorted(sorted) {
+		t.Fatal()
+	}
+	for i := 1; i < len(sorted); i++ {
+		if Compare(sorted[i-1], sorted[i]) >= 0 {
+			t.Fatal(sorted[i-1], sorted[i])
+		}
+	}
+	if sorted[0] != Of1Microsecond || sorted[len(sorted)-1] != Raw(MaxYear, Year) {
+		t.Fatal(sorted[0], sorted[len(sorted)-1])
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	in := []Interval{Of1Day, Raw(24, Month), Raw(24, Hour), Of1Minute, Raw(2, Year)}
+	if min := Min(in...); min != Of1Minute {
+		t.Fatal(min)
+	}
+	if max := Max(in...); max != Raw(2, Year) {
+		t.Fatal(max)
+	}
+	if Min() != 0 || Max() != 0 {
+		t.Fatal()
+	}
+}
+
+func TestIntervalsSort(t *testing.T) {
+	in := Intervals{Raw(24, Month), Of1Day, Of1Year, Raw(24, Hour), Raw(12, Month), Of1Week}
+	in.Sort()
+	ex := Intervals{Raw(24, Hour), Of1Day, Of1Week, Raw(12, Month), Of1Year, Raw(24, Month)}
+	if !reflect.DeepEqual(in, ex) {
+		t.Fatal(in, "!=", ex)
+	}
+}
+
+func TestCompareOver(t *testing.T) {
+	feb := tm("2021-02-01T00:00:00Z")
+	mar := tm("2021-03-01T00:00:00Z")
+	jan := tm("2021-01-01T00:00:00Z")
+	jul := tm("2021-07-01T00:00:00Z")
+
+	for idx, tc := range []struct {
+		a, b     Interval
+		from, to time.Time
+		ex       int
+	}{
+		{Raw(30, Day), Of1Month, feb, mar, 1},
+		{Raw(30, Day), Of1Month, jan, jul, -1},
+		{Raw(4, Week), Of1Month, feb, mar, -1},
+		{Raw(28, Day), Raw(4, Week), feb, mar, -1},
+		{Raw(24, Hour), Of1Day, jan, jul, -1},
+		{Of1Day, Raw(24, Hour), jan, jul, 1},
+		{Of1Day, Raw(24, Hour), jul, jan, 1}, // empty range uses Compare
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.a.CompareOver(tc.b, tc.from, tc.to); result != tc.ex {
+				t.Fatal(tc.a, tc.b, result, "!=", tc.ex)
+			}
+		})
+	}
+
+	in := Intervals{Of1Month, Raw(30, Day), Raw(4, Week)}
+	in.SortOver(feb, mar)
+	ex := Intervals{Raw(4, Week), Of1Month, Raw(30, Day)}
+	if !reflect.DeepEqual(in, ex) {
+		t.Fatal(in, "!=", ex)
+	}
+}
@@ -0,0 +1,145 @@
+package interval
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shabbyrobe/golib/times"
+)
+
+// Fiscal wraps an Interval so that Year and Quarter Periods are aligned to a
+// fiscal year that starts on the first day of StartMonth, rather than on the
+// first day of January. For all other Units, Fiscal behaves exactly like the
+// wrapped Interval.
+//
+// Fiscal years are named after the calendar year in which they end, so if
+// StartMonth is July, FY2025 runs from 2024-07-01 until 2025-07-01. If
+// StartMonth is January (or zero), the fiscal year is the calendar year.
+//
+// Periods returned by a Fiscal are not interchangeable with Periods returned
+// by the wrapped Interval for Year and Quarter Units.
+type Fiscal struct {
+	Interval   Interval
+	StartMonth time.Month
+}
+
+// Fiscal returns a Fiscal for this Interval with a fiscal year that starts on
+// the first day of startMonth.
+func (i Interval) Fiscal(startMonth time.Month) Fiscal {
+	return Fiscal{Interval: i, StartMonth: startMonth}
+}
+
+func (f Fiscal) startMonth() time.Month {
+	if f.StartMonth < time.January || f.StartMonth > time.December {
+		return time.January
+	}
+	return f.StartMonth
+}
+
+func (f Fiscal) isFiscal() bool {
+	u := f.Interval.Unit()
+	return u == Year || u == Quarter
+}
+
+// periodMonths returns the number of months in a single Period. Only valid if
+// isFiscal() is true.
+func (f Fiscal) periodMonths() int64 {
+	if f.Interval.Unit() == Year {
+		return int64(f.Interval.Qty()) * 12
+	}
+	return int64(f.Interval.Qty()) * 3
+}
+
+func (f Fiscal) Period(t time.Time) Period {
+	if !f.isFiscal() {
+		return f.Interval.Period(t)
+	}
+	months := int64(times.PeriodMonth(t)) - int64(f.startMonth()-1)
+	return Period(floorDiv(months, f.periodMonths()))
+}
+
+func (f Fiscal) Time(p Period, loc *time.Location) time.Time {
+	if !f.isFiscal() {
+		return f.Interval.Time(p, loc)
+	}
+	months := int64(p)*f.periodMonths() + int64(f.startMonth()-1)
+	return times.PeriodMonthsTime(int(months), 1, loc)
+}
+
+// Start returns the time that represents the inclusive start of the Period
+// that contains t.
+func (f Fiscal) Start(t time.Time) time.Time {
+	return f.Time(f.Period(t), t.Location())
+}
+
+// Next returns the time at the beginning of the period that starts after the
+// period that encapsulates the passed-in time.
+func (f Fiscal) Next(t time.Time) time.Time {
+	return f.Time(f.Period(t)+1, t.Location())
+}
+
+// Year returns the fiscal year that contains t, named after the calendar year
+// in which the fiscal year ends.
+func (f Fiscal) Year(t time.Time) int {
+	start := f.startMonth()
+	if start != time.January && t.Month() >= start {
+		return t.Year() + 1
+	}
+	return t.Year()
+}
+
+// Quarter returns the fiscal quarter (1-4) that contains t.
+func (f Fiscal) Quarter(t time.Time) int {
+	return ((int(t.Month())-int(f.startMonth())+12)%12)/3 + 1
+}
+
+func (f Fiscal) Format(p Period) string { return f.FormatIn(p, time.UTC) }
+
+// FormatAfter is the Fiscal equivalent of Interval.FormatAfter.
+func (f Fiscal) FormatAfter(current Period, prev Period) string {
+	return f.FormatAfterIn(current, prev, time.UTC)
+}
+
+// FormatIn formats Year Periods as "FY2025" and Quarter Periods as
+// "FY2025-Q1". All other Units are formatted by Interval.FormatIn.
+func (f Fiscal) FormatIn(p Period, in *time.Location) string {
+	tm := f.Time(p, in)
+	switch f.Interval.Unit() {
+	case Year:
+		return "FY" + strconv.Itoa(f.Year(tm))
+	case Quarter:
+		return fmt.Sprintf("FY%d-Q%d", f.Year(tm), f.Quarter(tm))
+	default:
+		return f.Interval.FormatIn(p, in)
+	}
+}
+
+// FormatAfterIn omits the fiscal year from Quarter Periods if it is the same
+// as the fiscal year of the previous Period. All other Units are formatted by
+// Interval.FormatAfterIn.
+func (f Fiscal) FormatAfterIn(current Period, prev Period, in *time.Location) string {
+	switch f.Interval.Unit() {
+	case Year:
+		return f.FormatIn(current, in)
+
+	case Quarter:
+		curTime, prevTime := f.Time(current, in), f.Time(prev, in)
+		if curTime.After(prevTime) && f.Year(curTime) == f.Year(prevTime) {
+			return "Q" + strconv.Itoa(f.Quarter(curTime))
+		}
+		return f.FormatIn(current, in)
+
+	default:
+		return f.Interval.FormatAfterIn(current, prev, in)
+	}
+}
+
+// formatQuarter formats a calendar quarter as "2024-Q3".
+func formatQuarter(tm time.Time) string {
+	return fmt.Sprintf("%d-Q%d", tm.Year(), calendarQuarter(tm))
+}
+
+func calendarQuarter(tm time.Time) int {
+	return (int(tm.Month())-1)/3 + 1
+}
@@ -0,0 +1,79 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFiscalPeriod(t *testing.T) {
+	for idx, tc := range []struct {
+		fiscal Fiscal
+		in     time.Time
+		start  time.Time
+		next   time.Time
+		format string
+	}{
+		{Of1Year.Fiscal(time.July), tm("2024-07-01T00:00:00Z"), tm("2024-07-01T00:00:00Z"), tm("2025-07-01T00:00:00Z"), "FY2025"},
+		{Of1Year.Fiscal(time.July), tm("2025-06-30T23:59:59Z"), tm("2024-07-01T00:00:00Z"), tm("2025-07-01T00:00:00Z"), "FY2025"},
+		{Of1Year.Fiscal(time.July), tm("2024-06-30T23:59:59Z"), tm("2023-07-01T00:00:00Z"), tm("2024-07-01T00:00:00Z"), "FY2024"},
+		{Of1Year.Fiscal(time.July), tm("1969-08-01T00:00:00Z"), tm("1969-07-01T00:00:00Z"), tm("1970-07-01T00:00:00Z"), "FY1970"},
+		{Of1Year.Fiscal(time.October), tm("2024-10-01T00:00:00Z"), tm("2024-10-01T00:00:00Z"), tm("2025-10-01T00:00:00Z"), "FY2025"},
+		{Of1Year.Fiscal(time.January), tm("2024-10-01T00:00:00Z"), tm("2024-01-01T00:00:00Z"), tm("2025-01-01T00:00:00Z"), "FY2024"},
+		{Of1Year.Fiscal(0), tm("2024-10-01T00:00:00Z"), tm("2024-01-01T00:00:00Z"), tm("2025-01-01T00:00:00Z"), "FY2024"},
+		{Raw(2, Year).Fiscal(time.April), tm("2024-10-01T00:00:00Z"), tm("2024-04-01T00:00:00Z"), tm("2026-04-01T00:00:00Z"), "FY2025"},
+
+		{Of1Quarter.Fiscal(time.July), tm("2024-07-01T00:00:00Z"), tm("2024-07-01T00:00:00Z"), tm("2024-10-01T00:00:00Z"), "FY2025-Q1"},
+		{Of1Quarter.Fiscal(time.July), tm("2025-05-15T00:00:00Z"), tm("2025-04-01T00:00:00Z"), tm("2025-07-01T00:00:00Z"), "FY2025-Q4"},
+		{Of1Quarter.Fiscal(time.February), tm("2025-01-15T00:00:00Z"), tm("2024-11-01T00:00:00Z"), tm("2025-02-01T00:00:00Z"), "FY2025-Q4"},
+		{Of1Quarter.Fiscal(time.January), tm("2024-08-15T00:00:00Z"), tm("2024-07-01T00:00:00Z"), tm("2024-10-01T00:00:00Z"), "FY2024-Q3"},
+
+		// Non-fiscal units are unaffected:
+		{Of1Month.Fiscal(time.July), tm("2024-08-15T00:00:00Z"), tm("2024-08-01T00:00:00Z"), tm("2024-09-01T00:00:00Z"), "2024-08-01Z"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			start := tc.fiscal.Start(tc.in)
+			if !start.Equal(tc.start) {
+				t.Fatal("start", start, "!=", tc.start)
+			}
+			next := tc.fiscal.Next(tc.in)
+			if !next.Equal(tc.next) {
+				t.Fatal("next", next, "!=", tc.next)
+			}
+			p := tc.fiscal.Period(tc.in)
+			if tc.fiscal.Period(start) != p || tc.fiscal.Period(next) != p+1 {
+				t.Fatal("period", p)
+			}
+			if result := tc.fiscal.Format(p); result != tc.format {
+				t.Fatal("format", result, "!=", tc.format)
+			}
+		})
+	}
+}
+
+func TestFiscalFormatAfter(t *testing.T) {
+	fq := Of1Quarter.Fiscal(time.July)
+	p := fq.Period(tm("2024-07-01T00:00:00Z"))
+
+	for idx, tc := range []struct {
+		cur, prev Period
+		out       string
+	}{
+		{p + 1, p, "Q2"},
+		{p + 3, p + 2, "Q4"},
+		{p + 4, p + 3, "FY2026-Q1"},
+		{p, p, "FY2025-Q1"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := fq.FormatAfter(tc.cur, tc.prev); result != tc.out {
+				t.Fatal(result, "!=", tc.out)
+			}
+		})
+	}
+
+	fy := Of1Year.Fiscal(time.July)
+	p = fy.Period(tm("2024-07-01T00:00:00Z"))
+	if result := fy.FormatAfter(p+1, p); result != "FY2026" {
+		t.Fatal(result)
+	}
+}
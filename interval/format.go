@@ -18,31 +18,62 @@ func (i Interval) FormatAfter(current Period, prev Period) string {
 }
 
 func (i Interval) FormatIn(p Period, in *time.Location) string {
+	return i.formatTime(i.Time(p, in), p)
+}
+
+// formatTime formats tm, the start of Period p, in the manner of FormatIn.
+func (i Interval) formatTime(tm time.Time, p Period) string {
 	switch i.Unit() {
+	case Microsecond:
+		return tm.Format("2006-01-02T15:04:05.000000Z07:00")
+	case Millisecond:
+		return tm.Format("2006-01-02T15:04:05.000Z07:00")
 	case Second:
-		return i.Time(p, in).Format(time.RFC3339)
+		return tm.Format(time.RFC3339)
 	case Minute:
-		return i.Time(p, in).Format("2006-01-02T15:04Z07:00")
+		return tm.Format("2006-01-02T15:04Z07:00")
 	case Hour:
-		return i.Time(p, in).Format("2006-01-02T15:00Z07:00")
+		return tm.Format("2006-01-02T15:00Z07:00")
 	case Day:
-		return i.Time(p, in).Format("2006-01-02Z07:00")
+		return tm.Format("2006-01-02Z07:00")
 	case Week:
-		return i.Time(p, in).Format("2006-01-02Z07:00")
+		return tm.Format("2006-01-02Z07:00")
 	case Month:
-		return i.Time(p, in).Format("2006-01-02Z07:00")
+		return tm.Format("2006-01-02Z07:00")
+	case Quarter:
+		return formatQuarter(tm)
 	case Year:
-		return i.Time(p, in).Format("2006Z07:00")
+		return tm.Format("2006Z07:00")
 	default:
 		return fmt.Sprintf("unknown unit %d for period %d", i.Unit(), p)
 	}
 }
 
+// Layout formats the start of a Period for FormatLayoutIn.
+// times.StrftimeLayout is a Layout that uses strftime patterns, for example:
+//
+//	layout := times.StrftimeLayout{Pattern: "%d %B %Y", Locale: times.LocaleFrench}
+//	Of1Day.FormatLayoutIn(p, layout, loc) == "05 mars 2024"
+//
+type Layout interface {
+	Format(t time.Time) string
+}
+
+// FormatLayoutIn formats the start of the Period using a custom Layout in
+// place of the fixed layouts used by FormatIn.
+func (i Interval) FormatLayoutIn(p Period, layout Layout, in *time.Location) string {
+	return layout.Format(i.Time(p, in))
+}
+
 func (i Interval) FormatShortIn(p Period, in *time.Location) string {
 	var tm = i.Time(p, in)
 
-	if tm.Second() != 0 || tm.Minute() != 0 || tm.Hour() != 0 {
-		if i.Unit() == Second {
+	if tm.Nanosecond() != 0 || tm.Second() != 0 || tm.Minute() != 0 || tm.Hour() != 0 {
+		if i.Unit() == Microsecond {
+			return tm.Format("15:04:05.000000")
+		} else if i.Unit() == Millisecond {
+			return tm.Format("15:04:05.000")
+		} else if i.Unit() == Second {
 			return tm.Format("15:04:05")
 		} else {
 			return tm.Format("15:04")
@@ -51,6 +82,9 @@ func (i Interval) FormatShortIn(p Period, in *time.Location) string {
 	} else if i.Unit() == Week {
 		return tm.Format("2006-01-02")
 
+	} else if i.Unit() == Quarter {
+		return formatQuarter(tm)
+
 	} else {
 		var firstDay = tm.Day() == 1
 		if tm.Month() == 1 && firstDay {
@@ -66,9 +100,12 @@ func (i Interval) FormatShortIn(p Period, in *time.Location) string {
 }
 
 func (i Interval) FormatAfterIn(current Period, prev Period, in *time.Location) string {
-	var curTime = i.Time(current, in)
-	var prevTime = i.Time(prev, in)
+	return i.formatAfter(i.Time(current, in), i.Time(prev, in))
+}
 
+// formatAfter formats curTime, the start of a Period, in the manner of
+// FormatAfterIn.
+func (i Interval) formatAfter(curTime, prevTime time.Time) string {
 	if !curTime.After(prevTime) {
 		return curTime.Format("2006-01-02T15:04:05Z")
 	}
@@ -94,7 +131,13 @@ func (i Interval) FormatAfterIn(current Period, prev Period, in *time.Location)
 	case Week:
 		return curTime.Format("2006-01-02")
 
-	case Hour, Minute, Second:
+	case Quarter:
+		if curTime.Year() == prevTime.Year() {
+			return "Q" + strconv.Itoa(calendarQuarter(curTime))
+		}
+		return formatQuarter(curTime)
+
+	case Hour, Minute, Second, Millisecond, Microsecond:
 		var dfmt, tfmt, tjoin string
 		var showTime bool
 
@@ -113,8 +156,15 @@ func (i Interval) FormatAfterIn(current Period, prev Period, in *time.Location)
 		hrEq := curTime.Hour() == prevTime.Hour()
 		mnEq := curTime.Minute() == prevTime.Minute()
 		scEq := curTime.Second() == prevTime.Second()
-
-		if i.Unit() == Second && curTime.Second() != 0 {
+		nsEq := curTime.Nanosecond() == prevTime.Nanosecond()
+
+		if i.Unit() == Microsecond && curTime.Nanosecond() != 0 {
+			tfmt = "15:04:05.000000"
+			showTime = !hrEq || !mnEq || !scEq || !nsEq
+		} else if i.Unit() == Millisecond && curTime.Nanosecond() != 0 {
+			tfmt = "15:04:05.000"
+			showTime = !hrEq || !mnEq || !scEq || !nsEq
+		} else if i.Unit() <= Second && curTime.Second() != 0 {
 			tfmt = "15:04:05"
 			showTime = !hrEq || !mnEq || !scEq
 		} else if !hrEq || !mnEq {
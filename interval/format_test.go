@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/shabbyrobe/golib/times"
 )
 
 func TestFormat(t *testing.T) {
@@ -12,6 +14,8 @@ func TestFormat(t *testing.T) {
 		period Period
 		out    string
 	}{
+		{Of1Microsecond, 10, "1970-01-01T00:00:00.000010Z"},
+		{Of1Millisecond, 10, "1970-01-01T00:00:00.010Z"},
 		{Of1Second, 10, "1970-01-01T00:00:10Z"},
 		{Of1Minute, 10, "1970-01-01T00:10Z"},
 		{Of1Hour, 10, "1970-01-01T10:00Z"},
@@ -19,6 +23,9 @@ func TestFormat(t *testing.T) {
 		{Of1Week, 10, "1970-03-09Z"},
 		{Of1Month, 10, "1970-11-01Z"},
 		{Of1Year, 10, "1980Z"},
+		{Of1Quarter, 0, "1970-Q1"},
+		{Of1Quarter, 218, "2024-Q3"},
+		{Of1Quarter, -1, "1969-Q4"},
 	} {
 		t.Run("", func(t *testing.T) {
 			result := tc.intvl.Format(tc.period)
@@ -55,12 +62,38 @@ func TestFormatIn(t *testing.T) {
 	}
 }
 
+func TestFormatLayoutIn(t *testing.T) {
+	loc := time.FixedZone("AEST", 36000)
+	for _, tc := range []struct {
+		intvl  Interval
+		layout Layout
+		period Period
+		out    string
+	}{
+		{Of1Hour, times.StrftimeLayout{Pattern: "%a %-d %b %H:%M"}, 20, "Fri 2 Jan 06:00"},
+		{Of1Day, times.StrftimeLayout{Pattern: "%A %d %B %Y", Locale: times.LocaleFrench}, 10, "dimanche 11 janvier 1970"},
+		{Of1Month, times.StrftimeLayout{Pattern: "%B %Y", Locale: times.LocaleGerman}, 2, "März 1970"},
+	} {
+		t.Run("", func(t *testing.T) {
+			result := tc.intvl.FormatLayoutIn(tc.period, tc.layout, loc)
+			if result != tc.out {
+				t.Fatal(result)
+			}
+		})
+	}
+}
+
 func TestFormatShort(t *testing.T) {
 	for _, tc := range []struct {
 		intvl  Interval
 		period Period
 		out    string
 	}{
+		{Of1Microsecond, 10, "00:00:00.000010"},
+		{Of1Millisecond, 0, "1970"},
+		{Of1Millisecond, 10, "00:00:00.010"},
+		{Of1Millisecond, 1000, "00:00:01.000"},
+
 		{Of1Second, 0, "1970"},
 		{Of1Second, 10, "00:00:10"},
 		{Of1Second, 60, "00:01:00"},
@@ -88,6 +121,9 @@ func TestFormatShort(t *testing.T) {
 		{Of1Month, 0, "1970"},
 		{Of1Month, 10, "1970-11"},
 		{Of1Month, 12, "1971"},
+
+		{Of1Quarter, 0, "1970-Q1"},
+		{Of1Quarter, 5, "1971-Q2"},
 	} {
 		t.Run(fmt.Sprintf("%s-%s", tc.intvl, tc.out), func(t *testing.T) {
 			result := tc.intvl.FormatShort(tc.period)
@@ -110,6 +146,12 @@ func TestFormatAfter(t *testing.T) {
 		cur   Period
 		out   string
 	}{
+		{Of1Microsecond, 0, 1, "00:00:00.000001"},
+		{Of1Millisecond, 0, 1, "00:00:00.001"},
+		{Of1Millisecond, 0, 1000, "00:00:01"},
+		{Of1Millisecond, 0, 86400001, "02-Jan 00:00:00.001"},
+		{Of100Milliseconds, 10, 12, "00:00:01.200"},
+
 		{Of1Second, 0, 1, "00:00:01"},
 		{Of1Second, 0, 86400, "02-Jan"},
 		{Of1Second, 0, 86401, "02-Jan 00:00:01"},
@@ -136,6 +178,9 @@ func TestFormatAfter(t *testing.T) {
 		{Of1Day, 0, 365, "1971"},
 		{Of1Day, 0, 366, "1971-01-02"},
 		{Of1Day, 0, 396, "1971-02"},
+
+		{Of1Quarter, 0, 1, "Q2"},
+		{Of1Quarter, 3, 4, "1971-Q1"},
 	} {
 		t.Run(fmt.Sprintf("%s-%s", tc.intvl, tc.out), func(t *testing.T) {
 			result := tc.intvl.FormatAfter(tc.cur, tc.prev)
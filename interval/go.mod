@@ -4,3 +4,5 @@ module github.com/shabbyrobe/golib/interval
 go 1.13
 
 require github.com/shabbyrobe/golib/times v0.0.0-20200215042454-96cb28ef70ba
+
+replace github.com/shabbyrobe/golib/times => ../times
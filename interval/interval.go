@@ -75,7 +75,8 @@ func (i Interval) Unit() Unit {
 
 // Less returns a best-effort guess as to whether one interval is smaller than
 // another. It is not 100% guaranteed to be accurate as it uses a reference
-// time.
+// time, and it is not a total order: 24hr and 1d are neither less than nor
+// greater than each other. Use Compare for a total order.
 func (i Interval) Less(j Interval) bool {
 	return i.LessAt(j, intervalRefTime)
 }
@@ -123,7 +124,7 @@ func (i Interval) CanCombineTo(to Interval) bool {
 	toUnit := to.Unit()
 
 	switch fromUnit {
-	case Second, Minute, Hour:
+	case Microsecond, Millisecond, Second, Minute, Hour:
 		if toUnit >= Day {
 			// Daylight saving time makes it impossible to cleanly combine
 			// "part of day" units into day-based units or greater.
@@ -143,7 +144,12 @@ func (i Interval) CanCombineTo(to Interval) bool {
 		}
 
 	case Month:
-		if toUnit != Month && toUnit != Year {
+		if toUnit != Month && toUnit != Quarter && toUnit != Year {
+			return false
+		}
+
+	case Quarter:
+		if toUnit != Quarter && toUnit != Year {
 			return false
 		}
 
@@ -160,6 +166,13 @@ func (i Interval) CanCombineTo(to Interval) bool {
 		return false
 	}
 
+	if toUnit < Day {
+		// "Part of day" units are all a fixed length and all start at the
+		// epoch, so there's no need to walk the periods. This also avoids
+		// walking billions of periods for microseconds.
+		return to.Duration()%i.Duration() == 0
+	}
+
 	startOfPeriod := i.Time(0, nil)
 	startOfToPeriod := to.Time(0, nil)
 
@@ -200,6 +213,32 @@ func (i Interval) Period(t time.Time) Period {
 
 	var out int64
 	switch i.Unit() {
+	case Microsecond:
+		un := t.UnixNano()
+		if un >= 0 {
+			out = ((un - (un % (int64(time.Microsecond) * qty))) / int64(time.Microsecond)) / qty
+		} else {
+			out = un
+			gap := un % (int64(time.Microsecond) * qty)
+			if gap != 0 {
+				out -= (int64(time.Microsecond) * qty) + gap
+			}
+			out = out / int64(time.Microsecond) / qty
+		}
+
+	case Millisecond:
+		un := t.UnixNano()
+		if un >= 0 {
+			out = ((un - (un % (int64(time.Millisecond) * qty))) / int64(time.Millisecond)) / qty
+		} else {
+			out = un
+			gap := un % (int64(time.Millisecond) * qty)
+			if gap != 0 {
+				out -= (int64(time.Millisecond) * qty) + gap
+			}
+			out = out / int64(time.Millisecond) / qty
+		}
+
 	case Second:
 		un := t.UnixNano()
 		if un >= 0 {
@@ -258,6 +297,9 @@ func (i Interval) Period(t time.Time) Period {
 	case Month:
 		out = int64(times.PeriodMonths(t, int(qty)))
 
+	case Quarter:
+		out = int64(times.PeriodMonths(t, int(qty)*3))
+
 	case Year:
 		y := int64(t.Year()) - 1970
 		if y >= 0 {
@@ -287,6 +329,10 @@ func (i Interval) Time(p Period, loc *time.Location) time.Time {
 
 	var out time.Time
 	switch i.Unit() {
+	case Microsecond:
+		out = time.Unix(0, int64(p)*qty*int64(time.Microsecond)).In(loc)
+	case Millisecond:
+		out = time.Unix(0, int64(p)*qty*int64(time.Millisecond)).In(loc)
 	case Second:
 		out = time.Unix(int64(p)*qty, 0).In(loc)
 	case Minute:
@@ -299,6 +345,8 @@ func (i Interval) Time(p Period, loc *time.Location) time.Time {
 		out = times.PeriodWeeksTime(int(p), int(qty), loc)
 	case Month:
 		out = times.PeriodMonthsTime(int(p), int(qty), loc)
+	case Quarter:
+		out = times.PeriodMonthsTime(int(p), int(qty)*3, loc)
 	case Year:
 		out = time.Date(int(int64(p)*qty)+1970, 1, 1, 0, 0, 0, 0, loc)
 	default:
@@ -317,6 +365,10 @@ func (i Interval) Start(t time.Time) time.Time {
 
 	var out time.Time
 	switch i.Unit() {
+	case Microsecond:
+		out = time.Unix(0, un-(un%(int64(time.Microsecond)*qty)))
+	case Millisecond:
+		out = time.Unix(0, un-(un%(int64(time.Millisecond)*qty)))
 	case Second:
 		out = time.Unix(0, un-(un%(int64(time.Second)*qty)))
 	case Minute:
@@ -329,6 +381,8 @@ func (i Interval) Start(t time.Time) time.Time {
 		out = times.TruncateWeeks(t, int(qty))
 	case Month:
 		out = times.TruncateMonths(t, int(qty))
+	case Quarter:
+		out = times.PeriodMonthsTime(times.PeriodMonths(t, int(qty)*3), int(qty)*3, t.Location())
 	case Year:
 		out = time.Date(t.Year()-(t.Year()%int(qty)), 1, 1, 0, 0, 0, 0, time.UTC)
 	default:
@@ -360,6 +414,15 @@ func (i Interval) Next(t time.Time) time.Time {
 	return i.Time(i.Period(t)+1, t.Location())
 }
 
+// NextFrom returns the beginning of the period that follows the current one
+// according to clock. If clock is nil, times.SystemClock is used.
+func (i Interval) NextFrom(clock times.Clock) time.Time {
+	if clock == nil {
+		clock = times.SystemClock
+	}
+	return i.Next(clock.Now())
+}
+
 // Prev will return the Period prior to the Period that t is contained within.
 //
 // For a daily interval:
@@ -49,6 +49,10 @@ var stringCases = []struct {
 	in       Interval
 	expected string
 }{
+	{Raw(1, Microsecond), "1us"},
+	{Raw(100, Microsecond), "100us"},
+	{Raw(1, Millisecond), "1ms"},
+	{Raw(250, Millisecond), "250ms"},
 	{Raw(1, Second), "1sec"},
 	{Raw(2, Second), "2sec"},
 	{Raw(1, Minute), "1min"},
@@ -63,6 +67,8 @@ var stringCases = []struct {
 	{Raw(2, Month), "2mo"},
 	{Raw(1, Year), "1yr"},
 	{Raw(2, Year), "2yr"},
+	{Raw(1, Quarter), "1qtr"},
+	{Raw(2, Quarter), "2qtr"},
 }
 
 func TestString(t *testing.T) {
@@ -153,6 +159,22 @@ func TestPeriodTime(t *testing.T) {
 		TestTime   time.Time
 		PeriodTime time.Time
 	}{
+		// 1 microsecond
+		{Raw(1, Microsecond), 0, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Raw(1, Microsecond), 0, time.Date(1970, 1, 1, 0, 0, 0, 999, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Raw(1, Microsecond), 1, time.Date(1970, 1, 1, 0, 0, 0, 1000, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 1000, time.UTC)},
+		{Raw(1, Microsecond), -1, time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC), time.Date(1969, 12, 31, 23, 59, 59, 999999000, time.UTC)},
+		{Raw(1, Microsecond), -1, time.Date(1969, 12, 31, 23, 59, 59, 999999000, time.UTC), time.Date(1969, 12, 31, 23, 59, 59, 999999000, time.UTC)},
+
+		// 250 millisecond
+		{Raw(250, Millisecond), 0, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Raw(250, Millisecond), 0, time.Date(1970, 1, 1, 0, 0, 0, 249999999, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Raw(250, Millisecond), 1, time.Date(1970, 1, 1, 0, 0, 0, 250000000, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 250000000, time.UTC)},
+		{Raw(250, Millisecond), 5, time.Date(1970, 1, 1, 0, 0, 1, 499999999, time.UTC), time.Date(1970, 1, 1, 0, 0, 1, 250000000, time.UTC)},
+		{Raw(250, Millisecond), -1, time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC), time.Date(1969, 12, 31, 23, 59, 59, 750000000, time.UTC)},
+		{Raw(250, Millisecond), -1, time.Date(1969, 12, 31, 23, 59, 59, 750000000, time.UTC), time.Date(1969, 12, 31, 23, 59, 59, 750000000, time.UTC)},
+		{Raw(250, Millisecond), -2, time.Date(1969, 12, 31, 23, 59, 59, 749999999, time.UTC), time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)},
+
 		// 1 second
 		{Raw(1, Second), 0, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
 		{Raw(1, Second), 0, time.Date(1970, 1, 1, 0, 0, 0, 999999999, time.UTC), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
@@ -397,6 +419,12 @@ func TestCanCombine(t *testing.T) {
 
 		{Of1Week, Raw(1, Minute), false},
 		{Of1Week, Of1Hour, false},
+		{Of1Millisecond, Of1Second, true},
+		{Raw(3, Millisecond), Of1Second, false},
+		{Of250Milliseconds, Of1Second, true},
+		{Of1Microsecond, Of1Hour, true},
+		{Of1Millisecond, Of1Day, false},
+
 		{Of1Week, Of1Day, false},
 		{Of1Week, Raw(14, Day), false},
 		{Of1Week, Of1Week, false},
@@ -410,6 +438,12 @@ func TestCanCombine(t *testing.T) {
 		{Of1Month, Of1Month, false},
 		{Of1Month, Raw(2, Month), true},
 		{Of1Month, Raw(1, Year), true},
+		{Of1Month, Of1Quarter, true},
+		{Raw(2, Month), Of1Quarter, false},
+		{Of1Quarter, Raw(2, Quarter), true},
+		{Of1Quarter, Raw(1, Year), true},
+		{Raw(3, Quarter), Raw(1, Year), false},
+		{Of1Quarter, Of1Month, false},
 		{Raw(2, Month), Raw(3, Month), false},
 		{Raw(2, Month), Raw(4, Month), true},
 		{Raw(2, Month), Raw(1, Year), true},
@@ -463,6 +497,12 @@ func TestStart(t *testing.T) {
 		{OfValid(2, Year), tm("2020-01-01T00:00:00Z"), tm("2020-01-01T00:00:00Z")},
 		{OfValid(2, Year), tm("2020-02-03T12:00:00Z"), tm("2020-01-01T00:00:00Z")},
 		{OfValid(2, Year), tm("2021-02-03T12:00:00Z"), tm("2020-01-01T00:00:00Z")},
+
+		{OfValid(1, Quarter), tm("2020-01-01T00:00:00Z"), tm("2020-01-01T00:00:00Z")},
+		{OfValid(1, Quarter), tm("2020-03-31T23:59:59Z"), tm("2020-01-01T00:00:00Z")},
+		{OfValid(1, Quarter), tm("2020-08-15T12:00:00Z"), tm("2020-07-01T00:00:00Z")},
+		{OfValid(3, Quarter), tm("1970-12-15T12:00:00Z"), tm("1970-10-01T00:00:00Z")},
+		{OfValid(1, Quarter), tm("1969-12-15T12:00:00Z"), tm("1969-10-01T00:00:00Z")},
 	} {
 		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
 			start := tc.i.Start(tc.t)
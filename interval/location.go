@@ -0,0 +1,106 @@
+package interval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shabbyrobe/golib/times"
+)
+
+// PeriodIn returns the Period that contains t, where the boundaries of Day,
+// Week, Month, Quarter and Year Periods are local midnights in loc rather than UTC
+// midnights. If loc is nil, UTC is used.
+//
+// Periods for units smaller than a Day are absolute and are the same as
+// those returned by Period.
+//
+// Periods returned by PeriodIn must be converted back to a time using TimeIn
+// with the same location; they are not interchangeable with the Periods
+// returned by Period for Day and Week units.
+func (i Interval) PeriodIn(t time.Time, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	qty := int64(i.Qty())
+
+	switch i.Unit() {
+	case Microsecond, Millisecond, Second, Minute, Hour:
+		return i.Period(t)
+
+	case Day:
+		return Period(floorDiv(localDays(t.In(loc)), qty))
+
+	case Week:
+		return Period(times.PeriodWeeksFrom(t.In(loc), int(qty), time.Monday))
+
+	case Month, Quarter:
+		return i.Period(t.In(loc))
+
+	case Year:
+		return Period(floorDiv(int64(t.In(loc).Year())-1970, qty))
+
+	default:
+		panic(fmt.Errorf("unknown unit %d", i.Unit()))
+	}
+}
+
+// TimeIn returns the start of the Period p as returned by PeriodIn, which is
+// local midnight in loc for Day, Week, Month, Quarter and Year units. If loc is nil,
+// UTC is used.
+//
+// If local midnight does not exist on the day the Period starts because of a
+// daylight saving transition, the time is normalised by time.Date, which will
+// usually result in 01:00.
+func (i Interval) TimeIn(p Period, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	qty := int64(i.Qty())
+
+	switch i.Unit() {
+	case Microsecond, Millisecond, Second, Minute, Hour, Month, Quarter:
+		return i.Time(p, loc)
+
+	case Day:
+		y, m, d := epochTime.UTC().AddDate(0, 0, int(int64(p)*qty)).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	case Week:
+		return times.PeriodWeeksTimeFrom(int(p), int(qty), time.Monday, loc)
+
+	case Year:
+		return time.Date(int(int64(p)*qty)+1970, 1, 1, 0, 0, 0, 0, loc)
+
+	default:
+		panic(fmt.Errorf("unknown unit %d", i.Unit()))
+	}
+}
+
+// StartIn returns the inclusive start of the Period that contains t, using the
+// local calendar boundaries described in PeriodIn.
+func (i Interval) StartIn(t time.Time, loc *time.Location) time.Time {
+	return i.TimeIn(i.PeriodIn(t, loc), loc)
+}
+
+// NextIn returns the start of the Period that follows the Period that contains
+// t, using the local calendar boundaries described in PeriodIn.
+func (i Interval) NextIn(t time.Time, loc *time.Location) time.Time {
+	return i.TimeIn(i.PeriodIn(t, loc)+1, loc)
+}
+
+// localDays returns the number of calendar days between the Unix epoch and the
+// date of t in t's location.
+func localDays(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+func floorDiv(n, d int64) int64 {
+	q := n / d
+	if (n%d != 0) && ((n < 0) != (d < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,114 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skip("location", name, "not available:", err)
+	}
+	return loc
+}
+
+func TestPeriodIn(t *testing.T) {
+	syd := loadLocation(t, "Australia/Sydney")
+	nyc := loadLocation(t, "America/New_York")
+
+	for idx, tc := range []struct {
+		intvl Interval
+		loc   *time.Location
+		in    time.Time
+		start time.Time
+		next  time.Time
+	}{
+		// 2020-01-01T05:00+11:00 is still 2019-12-31 in UTC:
+		{Of1Day, syd, time.Date(2020, 1, 1, 5, 0, 0, 0, syd),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, syd), time.Date(2020, 1, 2, 0, 0, 0, 0, syd)},
+
+		// Sydney DST ends 2020-04-05 03:00 -> 02:00, the day is 25 hours long:
+		{Of1Day, syd, time.Date(2020, 4, 5, 23, 30, 0, 0, syd),
+			time.Date(2020, 4, 5, 0, 0, 0, 0, syd), time.Date(2020, 4, 6, 0, 0, 0, 0, syd)},
+
+		// Sydney DST starts 2020-10-04 02:00 -> 03:00, the day is 23 hours long:
+		{Of1Day, syd, time.Date(2020, 10, 4, 0, 30, 0, 0, syd),
+			time.Date(2020, 10, 4, 0, 0, 0, 0, syd), time.Date(2020, 10, 5, 0, 0, 0, 0, syd)},
+
+		// New York DST starts 2020-03-08 02:00 -> 03:00:
+		{Of1Day, nyc, time.Date(2020, 3, 8, 22, 0, 0, 0, nyc),
+			time.Date(2020, 3, 8, 0, 0, 0, 0, nyc), time.Date(2020, 3, 9, 0, 0, 0, 0, nyc)},
+
+		// 2020-03-08T22:00-04:00 is 2020-03-09 in UTC:
+		{Of1Day, nyc, time.Date(2020, 3, 8, 22, 0, 0, 0, nyc).UTC(),
+			time.Date(2020, 3, 8, 0, 0, 0, 0, nyc), time.Date(2020, 3, 9, 0, 0, 0, 0, nyc)},
+
+		{Raw(2, Days), syd, time.Date(2020, 1, 2, 5, 0, 0, 0, syd),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, syd), time.Date(2020, 1, 3, 0, 0, 0, 0, syd)},
+
+		{Of1Week, syd, time.Date(2020, 4, 8, 12, 0, 0, 0, syd),
+			time.Date(2020, 4, 6, 0, 0, 0, 0, syd), time.Date(2020, 4, 13, 0, 0, 0, 0, syd)},
+		{Of1Week, syd, time.Date(2020, 4, 6, 0, 30, 0, 0, syd),
+			time.Date(2020, 4, 6, 0, 0, 0, 0, syd), time.Date(2020, 4, 13, 0, 0, 0, 0, syd)},
+		{Of1Week, nyc, time.Date(2020, 11, 1, 23, 0, 0, 0, nyc),
+			time.Date(2020, 10, 26, 0, 0, 0, 0, nyc), time.Date(2020, 11, 2, 0, 0, 0, 0, nyc)},
+		{Raw(3, Week), syd, time.Date(2017, 7, 1, 0, 0, 0, 0, syd),
+			time.Date(2017, 6, 26, 0, 0, 0, 0, syd), time.Date(2017, 7, 17, 0, 0, 0, 0, syd)},
+
+		{Of1Month, syd, time.Date(2020, 4, 1, 0, 30, 0, 0, syd),
+			time.Date(2020, 4, 1, 0, 0, 0, 0, syd), time.Date(2020, 5, 1, 0, 0, 0, 0, syd)},
+		{Of1Month, nyc, time.Date(2020, 3, 31, 23, 30, 0, 0, nyc),
+			time.Date(2020, 3, 1, 0, 0, 0, 0, nyc), time.Date(2020, 4, 1, 0, 0, 0, 0, nyc)},
+
+		{Of1Year, syd, time.Date(2020, 1, 1, 0, 30, 0, 0, syd),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, syd), time.Date(2021, 1, 1, 0, 0, 0, 0, syd)},
+		{Of1Year, nyc, time.Date(2020, 12, 31, 23, 30, 0, 0, nyc),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, nyc), time.Date(2021, 1, 1, 0, 0, 0, 0, nyc)},
+
+		// Pre-epoch:
+		{Of1Day, syd, time.Date(1969, 12, 31, 5, 0, 0, 0, syd),
+			time.Date(1969, 12, 31, 0, 0, 0, 0, syd), time.Date(1970, 1, 1, 0, 0, 0, 0, syd)},
+		{Of1Week, syd, time.Date(1969, 12, 28, 5, 0, 0, 0, syd),
+			time.Date(1969, 12, 22, 0, 0, 0, 0, syd), time.Date(1969, 12, 29, 0, 0, 0, 0, syd)},
+
+		// Sub-day units are absolute:
+		{Of1Hour, syd, time.Date(2020, 4, 5, 2, 30, 0, 0, syd),
+			time.Date(2020, 4, 5, 2, 0, 0, 0, syd), time.Date(2020, 4, 5, 3, 0, 0, 0, syd)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			start := tc.intvl.StartIn(tc.in, tc.loc)
+			if !start.Equal(tc.start) {
+				t.Fatal("start", start, "!=", tc.start)
+			}
+			next := tc.intvl.NextIn(tc.in, tc.loc)
+			if !next.Equal(tc.next) {
+				t.Fatal("next", next, "!=", tc.next)
+			}
+			p := tc.intvl.PeriodIn(tc.in, tc.loc)
+			if tc.intvl.PeriodIn(start, tc.loc) != p {
+				t.Fatal("period of start", tc.intvl.PeriodIn(start, tc.loc), "!=", p)
+			}
+			if tc.intvl.PeriodIn(next, tc.loc) != p+1 {
+				t.Fatal("period of next", tc.intvl.PeriodIn(next, tc.loc), "!=", p+1)
+			}
+		})
+	}
+}
+
+func TestPeriodInUTCMatchesPeriod(t *testing.T) {
+	for _, intvl := range []Interval{Of1Day, Raw(3, Days), Of1Month, Raw(5, Months), Of1Year, Raw(3, Years)} {
+		for _, tc := range []time.Time{
+			tm("1969-01-01T12:00:00Z"),
+			tm("1969-12-31T23:59:59Z"),
+			tm("1970-01-01T00:00:00Z"),
+			tm("2020-02-29T12:00:00Z"),
+		} {
+			if intvl.PeriodIn(tc, nil) != intvl.Period(tc) {
+				t.Fatal(intvl, tc, intvl.PeriodIn(tc, nil), "!=", intvl.Period(tc))
+			}
+		}
+	}
+}
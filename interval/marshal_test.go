@@ -0,0 +1,223 @@
+package interval
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = new(IntervalEncoded)
+	_ driver.Valuer = IntervalEncoded(0)
+	_ sql.Scanner   = &MomentEncoded{}
+	_ driver.Valuer = MomentEncoded{}
+	_ sql.Scanner   = &RangeEncoded{}
+	_ driver.Valuer = RangeEncoded{}
+)
+
+func TestIntervalEncodedBinary(t *testing.T) {
+	for idx, tc := range stringCases {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			bts, err := IntervalEncoded(tc.in).MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bts) != IntervalBinarySize {
+				t.Fatal(len(bts))
+			}
+			var out IntervalEncoded
+			if err := out.UnmarshalBinary(bts); err != nil {
+				t.Fatal(err)
+			}
+			if out.Interval() != tc.in {
+				t.Fatal(out.Interval(), "!=", tc.in)
+			}
+		})
+	}
+
+	var out IntervalEncoded
+	for _, in := range [][]byte{nil, {1}, {1, 2, 3}, {0xFF, 1}, {byte(Second), 0xFF}} {
+		if err := out.UnmarshalBinary(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
+
+func TestIntervalEncodedSQL(t *testing.T) {
+	v, err := IntervalEncoded(Of15Minutes).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "15min" {
+		t.Fatal(v)
+	}
+
+	for idx, tc := range []struct {
+		in interface{}
+		ex Interval
+	}{
+		{"15min", Of15Minutes},
+		{[]byte("1d"), Of1Day},
+		{int64(Of1Hour), Of1Hour},
+		{nil, 0},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			out := IntervalEncoded(Of1Year)
+			if err := out.Scan(tc.in); err != nil {
+				t.Fatal(err)
+			}
+			if out.Interval() != tc.ex {
+				t.Fatal(out.Interval(), "!=", tc.ex)
+			}
+		})
+	}
+
+	var out IntervalEncoded
+	for _, in := range []interface{}{"nope", int64(-1), int64(0x10000), 1.5} {
+		if err := out.Scan(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
+
+func TestMomentBinary(t *testing.T) {
+	for idx, m := range []Moment{
+		{Of1Minute, 0},
+		{Of1Minute, 1234},
+		{Of1Minute, -1234},
+		{Of1Quarter, 1 << 62},
+		{0, 0},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			bts, err := MomentEncoded(m).MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bts) != MomentBinarySize {
+				t.Fatal(len(bts))
+			}
+			var out MomentEncoded
+			if err := out.UnmarshalBinary(bts); err != nil {
+				t.Fatal(err)
+			}
+			if out.Moment() != m {
+				t.Fatal(out, "!=", m)
+			}
+
+			var scanned MomentEncoded
+			if err := scanned.Scan(bts); err != nil {
+				t.Fatal(err)
+			}
+			if scanned.Moment() != m {
+				t.Fatal(scanned, "!=", m)
+			}
+		})
+	}
+
+	var out MomentEncoded
+	if err := out.Scan("1min:-5"); err != nil {
+		t.Fatal(err)
+	}
+	if out.Moment() != (Moment{Of1Minute, -5}) {
+		t.Fatal(out)
+	}
+	for _, in := range []interface{}{[]byte{1, 2}, "1min", int64(1)} {
+		if err := out.Scan(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
+
+func TestMomentBinarySorts(t *testing.T) {
+	periods := []Period{-1 << 63, -1000, -1, 0, 1, 1000, 1<<63 - 1}
+	var last []byte
+	for _, p := range periods {
+		bts, _ := MomentEncoded{Of1Day, p}.MarshalBinary()
+		if last != nil && bytes.Compare(last, bts) >= 0 {
+			t.Fatal(p, "does not sort after previous")
+		}
+		last = bts
+	}
+}
+
+func TestRangeBinary(t *testing.T) {
+	for idx, r := range []Range{
+		{Of1Minute, 1234, 1240},
+		{Raw(3, Week), -10, -2},
+		{},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			v, err := RangeEncoded(r).Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			bts := v.([]byte)
+			if len(bts) != RangeBinarySize {
+				t.Fatal(len(bts))
+			}
+			var out RangeEncoded
+			if err := out.Scan(bts); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(out.Range(), r) {
+				t.Fatal(out, "!=", r)
+			}
+		})
+	}
+
+	var out RangeEncoded
+	if err := out.Scan("1min:1234..1240"); err != nil {
+		t.Fatal(err)
+	}
+	if out.Range() != (Range{Of1Minute, 1234, 1240}) {
+		t.Fatal(out)
+	}
+	if err := out.Scan(make([]byte, MomentBinarySize)); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestMomentRangeGob(t *testing.T) {
+	// Moment and Range are encoded by gob as plain structs; the binary form
+	// is only used by MomentEncoded and RangeEncoded:
+	for _, v := range []interface{}{Moment{}, Range{}} {
+		if _, ok := v.(encoding.BinaryMarshaler); ok {
+			t.Fatalf("%T implements encoding.BinaryMarshaler", v)
+		}
+	}
+
+	// Gob data written by earlier versions, when Moment and Range had no
+	// binary form, uses the plain struct encoding:
+	type moment struct {
+		Interval Interval
+		Period   Period
+	}
+	type rng struct {
+		Interval     Interval
+		Since, Until Period
+	}
+	var buf bytes.Buffer
+	old := struct {
+		M moment
+		R rng
+	}{moment{Of1Minute, -1234}, rng{Raw(3, Week), -10, -2}}
+	if err := gob.NewEncoder(&buf).Encode(old); err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		M Moment
+		R Range
+	}
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.M != (Moment{Of1Minute, -1234}) || out.R != (Range{Raw(3, Week), -10, -2}) {
+		t.Fatal(out)
+	}
+}
@@ -2,8 +2,10 @@ package interval
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func MustParse(intvl string) Interval {
@@ -16,41 +18,432 @@ func MustParse(intvl string) Interval {
 
 // Parse an interval from a string representation of the interval size
 // as an integer followed by the unit as a string, for example:
-//	"1min" == interval.Interval(1, interval.Minute)
-//	"1mo"  == interval.Interval(1, interval.Month)
+//	"1min"       == interval.Raw(1, interval.Minute)
+//	"1mo"        == interval.Raw(1, interval.Month)
+//	"15 minutes" == interval.Raw(15, interval.Minute)
 //
 // See ParseUnit for the list of supported unit strings.
 //
+// Parse also accepts compound durations, where each part has a quantity, and
+// ISO 8601 durations. If the input has more than one part, or its quantity
+// exceeds Unit.MaxQty, it is converted to the largest Unit that can represent
+// it exactly and whose Periods start at the same times. Weeks are never
+// combined with or converted from smaller units, and months are never
+// converted into quarters or years:
+//	"1h30min"  == interval.Raw(90, interval.Minute)
+//	"1d 12h"   == interval.Raw(36, interval.Hour)
+//	"120min"   == interval.Raw(2, interval.Hour)
+//	"168h"     == interval.Raw(7, interval.Day)
+//	"1y6mo"    == interval.Raw(18, interval.Month)
+//	"PT15M"    == interval.Raw(15, interval.Minute)
+//	"P1W"      == interval.Raw(1, interval.Week)
+//	"P3M"      == interval.Raw(3, interval.Month)
+//	"PT0.25S"  == interval.Raw(250, interval.Millisecond)
+//
+// Months, quarters and years can't be combined with smaller units as they
+// don't have a fixed duration. Zero durations, such as "0min" or "PT0S", are
+// rejected.
+//
+// Errors are returned as a *ParseError, which contains the position of the
+// problem in the input. If the input is a valid duration that can't be
+// represented by an Interval (for example, the quantity exceeds Unit.MaxQty),
+// ParseError.Nearest contains the nearest valid Interval. Use ParseNearest if
+// the nearest Interval is acceptable.
+//
 func Parse(intvl string) (Interval, error) {
-	intvl = strings.TrimSpace(intvl)
-	nidx := -1
-	for idx, c := range intvl {
-		if c < '0' || c > '9' {
-			break
+	parts, err := lexInterval(intvl)
+	if err != nil {
+		return 0, err
+	}
+	return resolveInterval(intvl, parts)
+}
+
+// ParseNearest is like Parse, but if the input is a valid duration that can't
+// be represented exactly by an Interval, the nearest valid Interval is
+// returned instead of an error.
+func ParseNearest(intvl string) (Interval, error) {
+	result, err := Parse(intvl)
+	if perr, ok := err.(*ParseError); ok && perr.Nearest != 0 {
+		return perr.Nearest, nil
+	}
+	return result, err
+}
+
+// ParseError is returned by Parse if the input is not a valid Interval.
+type ParseError struct {
+	Input string
+
+	// Pos is the byte offset into Input where the problem was found.
+	Pos int
+
+	Msg string
+
+	// Nearest is the nearest valid Interval to the duration described by
+	// Input, if Input was a valid duration. Otherwise it is zero.
+	Nearest Interval
+}
+
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("interval: %s at position %d in %q", e.Msg, e.Pos, e.Input)
+	if e.Nearest != 0 {
+		msg += fmt.Sprintf("; nearest valid interval is %s", e.Nearest)
+	}
+	return msg
+}
+
+type intervalPart struct {
+	qty    int64
+	hasQty bool
+	unit   Unit
+	pos    int
+}
+
+func lexInterval(s string) (parts []intervalPart, err error) {
+	i := skipSpace(s, 0)
+	if i == len(s) {
+		return nil, &ParseError{Input: s, Pos: i, Msg: "empty interval"}
+	}
+	if s[i] == 'P' || s[i] == 'p' {
+		return lexISO8601(s, i)
+	}
+
+	for i < len(s) {
+		part := intervalPart{qty: 1, pos: i}
+
+		numEnd := skipDigits(s, i)
+		if numEnd > i {
+			part.qty, err = strconv.ParseInt(s[i:numEnd], 10, 64)
+			if err != nil {
+				return nil, &ParseError{Input: s, Pos: i, Msg: fmt.Sprintf("invalid quantity %q", s[i:numEnd])}
+			}
+			part.hasQty = true
+		}
+
+		i = skipSpace(s, numEnd)
+		unitStart := i
+		for i < len(s) && !isDigit(s[i]) && !isSpace(s[i]) {
+			i++
+		}
+		if i == unitStart {
+			return nil, &ParseError{Input: s, Pos: i, Msg: "missing unit"}
 		}
-		nidx = idx
+		part.unit, err = ParseUnit(s[unitStart:i])
+		if err != nil {
+			return nil, &ParseError{Input: s, Pos: unitStart, Msg: fmt.Sprintf("unknown unit %q", s[unitStart:i])}
+		}
+
+		parts = append(parts, part)
+		i = skipSpace(s, i)
 	}
 
-	var qty int64
-	if nidx < 0 {
-		qty = 1
+	if len(parts) > 1 {
+		for _, part := range parts {
+			if !part.hasQty {
+				return nil, &ParseError{Input: s, Pos: part.pos, Msg: "missing quantity in compound interval"}
+			}
+		}
+	}
+	return parts, nil
+}
+
+// lexISO8601 lexes an ISO 8601 duration in the format
+// "P[n]Y[n]M[n]W[n]DT[n]H[n]M[n]S", starting at the 'P'. Only the seconds may
+// have a fractional part, which must be a whole number of microseconds.
+func lexISO8601(s string, i int) (parts []intervalPart, err error) {
+	fail := func(pos int, msg string) ([]intervalPart, error) {
+		return nil, &ParseError{Input: s, Pos: pos, Msg: msg}
+	}
+
+	end := len(s)
+	for end > i && isSpace(s[end-1]) {
+		end--
+	}
+
+	i++ // Skip 'P'
+	inTime := false
+	rank := 0
 
-	} else {
-		var err error
-		qty, err = strconv.ParseInt(intvl[:nidx+1], 10, 64)
+	for i < end {
+		if s[i] == 'T' || s[i] == 't' {
+			if inTime {
+				return fail(i, "unexpected 'T' in ISO 8601 duration")
+			}
+			inTime = true
+			i++
+			if i == end {
+				return fail(i, "missing time in ISO 8601 duration")
+			}
+			continue
+		}
+
+		numStart := i
+		i = skipDigits(s, i)
+		if i == numStart {
+			return fail(i, "expected number in ISO 8601 duration")
+		}
+		qty, err := strconv.ParseInt(s[numStart:i], 10, 64)
 		if err != nil {
-			return 0, err
+			return fail(numStart, fmt.Sprintf("invalid quantity %q", s[numStart:i]))
+		}
+
+		fracStart, fracEnd := i, i
+		if i < end && (s[i] == '.' || s[i] == ',') {
+			fracStart = i + 1
+			fracEnd = skipDigits(s, fracStart)
+			i = fracEnd
+		}
+
+		if i == end {
+			return fail(i, "missing designator in ISO 8601 duration")
 		}
+
+		var unit Unit
+		var unitRank int
+		switch c := s[i] &^ 0x20; { // ASCII upper case
+		case !inTime && c == 'Y':
+			unit, unitRank = Year, 1
+		case !inTime && c == 'M':
+			unit, unitRank = Month, 2
+		case !inTime && c == 'W':
+			unit, unitRank = Week, 3
+		case !inTime && c == 'D':
+			unit, unitRank = Day, 4
+		case inTime && c == 'H':
+			unit, unitRank = Hour, 5
+		case inTime && c == 'M':
+			unit, unitRank = Minute, 6
+		case inTime && c == 'S':
+			unit, unitRank = Second, 7
+		default:
+			return fail(i, fmt.Sprintf("unexpected designator %q in ISO 8601 duration", s[i]))
+		}
+		if unitRank <= rank {
+			return fail(i, fmt.Sprintf("designator %q out of order in ISO 8601 duration", s[i]))
+		}
+		rank = unitRank
+
+		if fracEnd == fracStart {
+			parts = append(parts, intervalPart{qty: qty, hasQty: true, unit: unit, pos: numStart})
+			i++
+			continue
+		}
+
+		if unit != Second {
+			return fail(fracStart-1, "only seconds may have a fraction in ISO 8601 duration")
+		}
+		frac := s[fracStart:fracEnd]
+		if len(frac) > 6 {
+			if strings.Trim(frac[6:], "0") != "" {
+				return fail(fracStart+6, "fraction smaller than a microsecond in ISO 8601 duration")
+			}
+			frac = frac[:6]
+		}
+		usec, _ := strconv.ParseInt(frac+strings.Repeat("0", 6-len(frac)), 10, 64)
+
+		if qty > 0 || usec == 0 {
+			parts = append(parts, intervalPart{qty: qty, hasQty: true, unit: Second, pos: numStart})
+		}
+		if usec%1000 == 0 && usec > 0 {
+			parts = append(parts, intervalPart{qty: usec / 1000, hasQty: true, unit: Millisecond, pos: numStart})
+		} else if usec > 0 {
+			parts = append(parts, intervalPart{qty: usec, hasQty: true, unit: Microsecond, pos: numStart})
+		}
+		i++
 	}
 
-	unit, err := ParseUnit(intvl[nidx+1:])
-	if err != nil {
-		return 0, err
+	if rank == 0 {
+		return fail(i, "empty ISO 8601 duration")
 	}
-	if err := Validate(unit, Qty(qty)); err != nil {
-		return 0, err
+	return parts, nil
+}
+
+func resolveInterval(s string, parts []intervalPart) (Interval, error) {
+	if len(parts) == 1 {
+		part := parts[0]
+		if part.qty > 0 && part.qty <= int64(part.unit.MaxQty()) {
+			return Raw(Qty(part.qty), part.unit), nil
+		}
+	}
+
+	// Calendar units are accumulated separately from fixed units as they
+	// can't be converted between each other exactly:
+	var months, nanos int64
+	for _, part := range parts {
+		if size := unitMonths(part.unit); size > 0 {
+			if nanos > 0 {
+				return 0, &ParseError{Input: s, Pos: part.pos, Msg: "cannot combine months, quarters or years with smaller units"}
+			}
+			if part.qty > (math.MaxInt64-months)/size {
+				return 0, &ParseError{Input: s, Pos: part.pos, Msg: "quantity too large"}
+			}
+			months += part.qty * size
+
+		} else {
+			if months > 0 {
+				return 0, &ParseError{Input: s, Pos: part.pos, Msg: "cannot combine months, quarters or years with smaller units"}
+			}
+			size := int64(unitNanos(part.unit))
+			if part.qty > (math.MaxInt64-nanos)/size {
+				return 0, &ParseError{Input: s, Pos: part.pos, Msg: "quantity too large"}
+			}
+			nanos += part.qty * size
+		}
+	}
+
+	pos := parts[0].pos
+	if months == 0 && nanos == 0 {
+		if len(parts) == 1 {
+			return 0, &ParseError{Input: s, Pos: pos, Msg: "interval must not be zero"}
+		}
+		return 0, &ParseError{Input: s, Pos: pos, Msg: "compound interval must not be zero"}
+	}
+
+	// Find the largest Unit that represents the total exactly, so "120min"
+	// becomes 2hr even though 120 is too large for Minute. Only Units whose
+	// Periods line up with every part are considered, so the result never
+	// buckets differently to the input:
+	for i := len(Units) - 1; i >= 0; i-- {
+		unit := Units[i]
+		if !canResolveTo(parts, unit) {
+			continue
+		}
+		var total, size int64
+		if months > 0 {
+			total, size = months, unitMonths(unit)
+		} else {
+			total, size = nanos, int64(unitNanos(unit))
+		}
+		if size > 0 && total%size == 0 && total/size <= int64(unit.MaxQty()) {
+			return Raw(Qty(total/size), unit), nil
+		}
+	}
+
+	if len(parts) == 1 {
+		part := parts[0]
+		return 0, &ParseError{
+			Input:   s,
+			Pos:     pos,
+			Msg:     fmt.Sprintf("qty too large for %s: expected <= %d, found %d", part.unit, part.unit.MaxQty(), part.qty),
+			Nearest: nearestInterval(months, nanos),
+		}
+	}
+
+	return 0, &ParseError{
+		Input:   s,
+		Pos:     pos,
+		Msg:     "duration cannot be represented by an interval",
+		Nearest: nearestInterval(months, nanos),
+	}
+}
+
+// canResolveTo reports whether every part can be expressed in unit without
+// changing where Periods start. Microsecond to Day Periods are all counted
+// from the epoch, as are Month Periods, but Week Periods start on Mondays and
+// a multiple of Month isn't converted into Quarter or Year.
+func canResolveTo(parts []intervalPart, unit Unit) bool {
+	for _, part := range parts {
+		switch unit {
+		case Week, Quarter, Year:
+			if part.unit != unit {
+				return false
+			}
+		case Month:
+			if unitMonths(part.unit) == 0 {
+				return false
+			}
+		default:
+			if unitMonths(part.unit) > 0 || part.unit == Week {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nearestInterval finds the Interval closest in size to the number of months
+// or nanoseconds passed in (only one may be non-zero). If months is non-zero,
+// only Month, Quarter and Year are considered. Ties are resolved in favour of
+// the larger Unit.
+func nearestInterval(months, nanos int64) (found Interval) {
+	target := float64(nanos) / float64(time.Second)
+	if months > 0 {
+		target = float64(months)
+	}
+
+	best := math.Inf(1)
+	for _, unit := range Units {
+		var size float64
+		if months > 0 {
+			size = float64(unitMonths(unit))
+		} else if size = float64(unitNanos(unit)) / float64(time.Second); size == 0 {
+			size = float64(unitMonths(unit)) * meanMonth.Seconds()
+		}
+		if size == 0 {
+			continue
+		}
+
+		max := float64(unit.MaxQty())
+		for _, qty := range []float64{math.Floor(target / size), math.Ceil(target / size)} {
+			qty = math.Max(1, math.Min(qty, max))
+			if diff := math.Abs(qty*size - target); diff <= best {
+				best, found = diff, Raw(Qty(qty), unit)
+			}
+		}
+	}
+	return found
+}
+
+// unitNanos returns the size of the Unit in nanoseconds if the Unit is smaller
+// than a Month, otherwise zero. Days are treated as 24 hours.
+func unitNanos(unit Unit) time.Duration {
+	switch unit {
+	case Microsecond:
+		return time.Microsecond
+	case Millisecond:
+		return time.Millisecond
+	case Second:
+		return time.Second
+	case Minute:
+		return time.Minute
+	case Hour:
+		return time.Hour
+	case Day:
+		return 24 * time.Hour
+	case Week:
+		return 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+// unitMonths returns the size of the Unit in months if the Unit is a Month or
+// larger, otherwise zero.
+func unitMonths(unit Unit) int64 {
+	switch unit {
+	case Month:
+		return 1
+	case Quarter:
+		return 3
+	case Year:
+		return 12
+	}
+	return 0
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+func isSpace(c byte) bool { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+
+func skipDigits(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
 	}
-	return Raw(Qty(qty), unit), err
+	return i
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+	return i
 }
 
 // ParseIntervalPeriod parses a string representing an interval combined with a
@@ -83,6 +476,14 @@ fail:
 
 func Validate(unit Unit, qty Qty) error {
 	switch unit {
+	case Microsecond:
+		if qty > MaxMicrosecond {
+			return fmt.Errorf("interval: qty too large for microseconds: expected <= %d, found %d", MaxMicrosecond, qty)
+		}
+	case Millisecond:
+		if qty > MaxMillisecond {
+			return fmt.Errorf("interval: qty too large for milliseconds: expected <= %d, found %d", MaxMillisecond, qty)
+		}
 	case Second:
 		if qty > MaxSecond {
 			return fmt.Errorf("interval: qty too large for seconds: expected <= %d, found %d", MaxSecond, qty)
@@ -107,6 +508,10 @@ func Validate(unit Unit, qty Qty) error {
 		if qty > MaxMonth {
 			return fmt.Errorf("interval: qty too large for months: expected <= %d, found %d", MaxMonth, qty)
 		}
+	case Quarter:
+		if qty > MaxQuarter {
+			return fmt.Errorf("interval: qty too large for quarters: expected <= %d, found %d", MaxQuarter, qty)
+		}
 	case Year:
 		if qty > MaxYear {
 			return fmt.Errorf("interval: qty too large for years: expected <= %d, found %d", MaxYear, qty)
@@ -121,17 +526,26 @@ func Validate(unit Unit, qty Qty) error {
 //
 // Supported unit strings are:
 //
-//	Second == "s", "sec", "secs", "second", "seconds"
-//	Minute == "min", "mins", "minute", "minutes"
-//	Hour   == "h", "hr", "hrs", "hour", "hours"
-//	Day    == "d", "ds", "day", "days"
-//	Week   == "w", "ws", "wk", "wks", "weeks"
-//	Month  == "mo", "mos", "month", "months"
-//	Year   == "y", "yr", "ys", "yrs", "year", "years"
+//	Microsecond == "us", "µs", "usec", "usecs", "microsecond", "microseconds"
+//	Millisecond == "ms", "msec", "msecs", "millisecond", "milliseconds"
+//	Second      == "s", "sec", "secs", "second", "seconds"
+//	Minute      == "min", "mins", "minute", "minutes"
+//	Hour        == "h", "hr", "hrs", "hour", "hours"
+//	Day         == "d", "ds", "day", "days"
+//	Week        == "w", "ws", "wk", "wks", "weeks"
+//	Month       == "mo", "mos", "month", "months"
+//	Quarter     == "q", "qs", "qtr", "qtrs", "quarter", "quarters"
+//	Year        == "y", "yr", "ys", "yrs", "year", "years"
 //
 func ParseUnit(sstr string) (unit Unit, err error) {
 	ips := strings.ToLower(strings.TrimSpace(sstr))
 	switch ips {
+	case "us", "µs", "usec", "usecs", "microsecond", "microseconds":
+		unit = Microsecond
+
+	case "ms", "msec", "msecs", "millisecond", "milliseconds":
+		unit = Millisecond
+
 	case "s", "sec", "secs", "second", "seconds":
 		unit = Second
 
@@ -150,6 +564,9 @@ func ParseUnit(sstr string) (unit Unit, err error) {
 	case "mo", "mos", "mnth", "mnths", "month", "months":
 		unit = Month
 
+	case "q", "qs", "qtr", "qtrs", "quarter", "quarters":
+		unit = Quarter
+
 	case "y", "ys", "yr", "yrs", "year", "years":
 		unit = Year
 
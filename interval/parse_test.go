@@ -26,11 +26,57 @@ func TestParse(t *testing.T) {
 		{"10 mins", Raw(10, Minute)},
 		{"10 minute", Raw(10, Minute)},
 		{"10 minutes", Raw(10, Minute)},
+
+		{"q", Raw(1, Quarter)},
+		{"2qtr", Raw(2, Quarter)},
+		{"1 quarter", Raw(1, Quarter)},
+
+		{"250ms", Raw(250, Millisecond)},
+		{"1 msec", Raw(1, Millisecond)},
+		{"10 milliseconds", Raw(10, Millisecond)},
+		{"100us", Raw(100, Microsecond)},
+		{"100µs", Raw(100, Microsecond)},
+		{"1 microsecond", Raw(1, Microsecond)},
+
+		{"1h30min", Raw(90, Minute)},
+		{"1 hour 30 minutes", Raw(90, Minute)},
+		{"1d 12h", Raw(36, Hour)},
+		{"1h60min", Raw(2, Hour)},
+		{"1y6mo", Raw(18, Month)},
+		{"1y 2q", Raw(18, Month)},
+		{"3mo 3mo", Raw(6, Month)},
+		{"1wk 1wk", Raw(2, Week)},
+		{"2q 2q", Raw(4, Quarter)},
+		{"6mo6mo", Raw(12, Month)},
+
+		{"PT15M", Raw(15, Minute)},
+		{"P1W", Raw(1, Week)},
+		{"P3M", Raw(3, Month)},
+		{"P1Y", Raw(1, Year)},
+		{"P2D", Raw(2, Day)},
+		{"PT1H", Raw(1, Hour)},
+		{"PT30S", Raw(30, Second)},
+		{"pt15m", Raw(15, Minute)},
+		{" PT15M ", Raw(15, Minute)},
+		{"PT1H30M", Raw(90, Minute)},
+		{"P1DT12H", Raw(36, Hour)},
+		{"P1Y6M", Raw(18, Month)},
+		{"P1Y1M", Raw(13, Month)},
+		{"PT0.25S", Raw(250, Millisecond)},
+		{"PT0,1S", Raw(100, Millisecond)},
+		{"PT0.000100S", Raw(100, Microsecond)},
+		{"PT0.0001000S", Raw(100, Microsecond)},
+		{"PT1.000S", Raw(1, Second)},
+		{"120min", Raw(2, Hour)},
+		{"PT120M", Raw(2, Hour)},
+		{"1000us", Raw(1, Millisecond)},
+		{"168h", Raw(7, Day)},
+		{"10080min", Raw(7, Day)},
 	} {
 		t.Run(fmt.Sprintf("valid/%d", idx), func(t *testing.T) {
 			result := MustParse(tc.in)
 			if result != tc.expected {
-				t.Fatal(result != tc.expected)
+				t.Fatal(tc.in, result, "!=", tc.expected)
 			}
 		})
 	}
@@ -38,12 +84,36 @@ func TestParse(t *testing.T) {
 	for idx, tc := range []struct {
 		in string
 	}{
-		{"q"},
 		{"s s"},
 		{"-1s"},
 		{"-s"},
 		{"1m"},
 		{"2soc"},
+		{"251ms"},
+		{"9q"},
+		{""},
+		{"  "},
+		{"10"},
+		{"1h min"},
+		{"1s500ms"},
+		{"1mo 1d"},
+		{"1d 1mo"},
+		{"0h0min"},
+		{"0min"},
+		{"0s"},
+		{"PT0S"},
+		{"P0D"},
+		{"PT0H0M"},
+		{"P"},
+		{"PT"},
+		{"P1H"},
+		{"PT1D"},
+		{"P1M1Y"},
+		{"P1.5D"},
+		{"PT0.0000001S"},
+		{"P1"},
+		{"PT1H T1M"},
+		{"99999999999999999999s"},
 	} {
 		t.Run(fmt.Sprintf("invalid/%d", idx), func(t *testing.T) {
 			_, err := Parse(tc.in)
@@ -53,3 +123,54 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseError(t *testing.T) {
+	for idx, tc := range []struct {
+		in      string
+		pos     int
+		nearest Interval
+	}{
+		{"2soc", 1, 0},
+		{"  2soc", 3, 0},
+		{"1h 2soc", 4, 0},
+		{"1m", 1, 0},
+		{"1h min", 3, 0},
+		{"1mo 1d", 4, 0},
+		{"P1H", 2, 0},
+		{"PT1H2X", 5, 0},
+		{"251ms", 0, Raw(250, Millisecond)},
+		{"100min", 0, Raw(90, Minute)},
+		{"9q", 0, Raw(2, Year)},
+		{"0min", 0, 0},
+		{"P0D", 1, 0},
+		{"1s500ms", 0, Raw(2, Second)},
+		{"PT1.5S", 2, Raw(2, Second)},
+		{"36mo", 0, Raw(3, Year)},
+		{"P36M", 1, Raw(3, Year)},
+		{"1wk 7d", 0, Raw(2, Week)},
+		{"1000h", 0, Raw(6, Week)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			_, err := Parse(tc.in)
+			perr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatal(err)
+			}
+			if perr.Pos != tc.pos {
+				t.Fatal(perr.Pos, "!=", tc.pos, perr)
+			}
+			if perr.Nearest != tc.nearest {
+				t.Fatal(perr.Nearest, "!=", tc.nearest, perr)
+			}
+
+			nearest, err := ParseNearest(tc.in)
+			if tc.nearest == 0 {
+				if err == nil {
+					t.Fatal()
+				}
+			} else if err != nil || nearest != tc.nearest {
+				t.Fatal(nearest, "!=", tc.nearest, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,14 @@
 package interval
 
 const (
+	Of1Microsecond    = Interval((uint(Microsecond) << 8) | uint(1))
+	Of10Microseconds  = Interval((uint(Microsecond) << 8) | uint(10))
+	Of100Microseconds = Interval((uint(Microsecond) << 8) | uint(100))
+	Of1Millisecond    = Interval((uint(Millisecond) << 8) | uint(1))
+	Of10Milliseconds  = Interval((uint(Millisecond) << 8) | uint(10))
+	Of100Milliseconds = Interval((uint(Millisecond) << 8) | uint(100))
+	Of250Milliseconds = Interval((uint(Millisecond) << 8) | uint(250))
+
 	Of1Second   = Interval((uint(Second) << 8) | uint(1))
 	Of2Seconds  = Interval((uint(Second) << 8) | uint(2))
 	Of5Seconds  = Interval((uint(Second) << 8) | uint(5))
@@ -31,5 +39,6 @@ const (
 	Of7Days     = Interval((uint(Day) << 8) | uint(7))
 	Of1Week     = Interval((uint(Week) << 8) | uint(1))
 	Of1Month    = Interval((uint(Month) << 8) | uint(1))
+	Of1Quarter  = Interval((uint(Quarter) << 8) | uint(1))
 	Of1Year     = Interval((uint(Year) << 8) | uint(1))
 )
@@ -0,0 +1,265 @@
+package interval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewRange returns the Range of the Periods of intvl that overlap the half-open span of
+// time [since, until).
+//
+// If until does not fall exactly on the start of a Period, the Range is extended to
+// include the Period that contains it:
+//
+//	NewRange(Of1Hour, "2020-01-01T00:30Z", "2020-01-01T02:00Z") == 00:00Z, 01:00Z
+//	NewRange(Of1Hour, "2020-01-01T00:30Z", "2020-01-01T02:30Z") == 00:00Z, 01:00Z, 02:00Z
+//
+// If until is not after since, the Range will be empty.
+func NewRange(intvl Interval, since, until time.Time) Range {
+	r := Range{Interval: intvl, Since: intvl.Period(since)}
+	if !until.After(since) {
+		r.Until = r.Since
+		return r
+	}
+	r.Until = intvl.Period(until)
+	if !intvl.Time(r.Until, nil).Equal(until) {
+		r.Until++
+	}
+	return r
+}
+
+// NewRangeMoments returns the Range of Periods starting at since and ending at (but not
+// including) until. The Moments must share the same Interval.
+func NewRangeMoments(since, until Moment) (Range, error) {
+	if since.Interval != until.Interval {
+		return Range{}, fmt.Errorf("interval: range moments have different intervals %s and %s", since.Interval, until.Interval)
+	}
+	return Range{Interval: since.Interval, Since: since.Period, Until: until.Period}, nil
+}
+
+// Len returns the number of Periods in the Range.
+func (r Range) Len() int64 {
+	if r.Until <= r.Since {
+		return 0
+	}
+	return int64(r.Until - r.Since)
+}
+
+func (r Range) IsEmpty() bool { return r.Until <= r.Since }
+
+// Contains reports whether the Period is within the half-open Range [Since, Until).
+func (r Range) Contains(p Period) bool {
+	return p >= r.Since && p < r.Until
+}
+
+// ContainsTime reports whether the Period that contains t is within the Range.
+func (r Range) ContainsTime(t time.Time) bool {
+	return r.Contains(r.Interval.Period(t))
+}
+
+// SinceTime returns the inclusive start time of the Range.
+func (r Range) SinceTime(loc *time.Location) time.Time {
+	return r.Interval.Time(r.Since, loc)
+}
+
+// UntilTime returns the exclusive end time of the Range.
+func (r Range) UntilTime(loc *time.Location) time.Time {
+	return r.Interval.Time(r.Until, loc)
+}
+
+// First returns the Moment of the first Period in the Range. The result is meaningless
+// if the Range is empty.
+func (r Range) First() Moment {
+	return Moment{Interval: r.Interval, Period: r.Since}
+}
+
+// Last returns the Moment of the last Period in the Range. The result is meaningless
+// if the Range is empty.
+func (r Range) Last() Moment {
+	return Moment{Interval: r.Interval, Period: r.Until - 1}
+}
+
+// Each calls fn with the Moment for each Period in the Range, in order, until fn
+// returns false.
+func (r Range) Each(fn func(m Moment) bool) {
+	for p := r.Since; p < r.Until; p++ {
+		if !fn(Moment{Interval: r.Interval, Period: p}) {
+			return
+		}
+	}
+}
+
+// Iter returns a RangeIter that yields the Moment for each Period in the Range:
+//
+//	iter := rng.Iter()
+//	for iter.Next() {
+//		fmt.Println(iter.Moment())
+//	}
+//
+func (r Range) Iter() *RangeIter {
+	return &RangeIter{rng: r, cur: r.Since - 1}
+}
+
+// Moments returns a slice containing the Moment for each Period in the Range.
+func (r Range) Moments() []Moment {
+	out := make([]Moment, 0, r.Len())
+	for p := r.Since; p < r.Until; p++ {
+		out = append(out, Moment{Interval: r.Interval, Period: p})
+	}
+	return out
+}
+
+// Split divides the Range into at most n contiguous Ranges of as close to equal length
+// as possible. Where the Range does not divide evenly, the earlier Ranges are one
+// Period longer than the later ones.
+//
+// If the Range contains fewer than n Periods, one Range is returned for each Period.
+// If the Range is empty or n <= 0, Split returns nil.
+func (r Range) Split(n int) []Range {
+	rlen := r.Len()
+	if rlen == 0 || n <= 0 {
+		return nil
+	}
+	if int64(n) > rlen {
+		n = int(rlen)
+	}
+
+	size, rem := rlen/int64(n), rlen%int64(n)
+	out := make([]Range, n)
+	since := r.Since
+	for i := 0; i < n; i++ {
+		until := since + Period(size)
+		if int64(i) < rem {
+			until++
+		}
+		out[i] = Range{Interval: r.Interval, Since: since, Until: until}
+		since = until
+	}
+	return out
+}
+
+// Intersect returns the Range of Periods that are contained in both r and o. If the
+// Ranges have different Intervals, or do not overlap, ok is false.
+func (r Range) Intersect(o Range) (result Range, ok bool) {
+	if r.Interval != o.Interval {
+		return Range{}, false
+	}
+	result = r
+	if o.Since > result.Since {
+		result.Since = o.Since
+	}
+	if o.Until < result.Until {
+		result.Until = o.Until
+	}
+	if result.IsEmpty() {
+		return Range{}, false
+	}
+	return result, true
+}
+
+// Union returns the smallest Range that contains all of the Periods in both r and o.
+// If the Ranges have different Intervals, or are neither overlapping nor adjacent (in
+// which case the result would contain Periods that are in neither Range), ok is false.
+//
+// If either Range is empty, the other Range is returned.
+func (r Range) Union(o Range) (result Range, ok bool) {
+	if r.Interval != o.Interval {
+		return Range{}, false
+	}
+	if r.IsEmpty() {
+		return o, true
+	} else if o.IsEmpty() {
+		return r, true
+	}
+	if o.Since > r.Until || r.Since > o.Until {
+		return Range{}, false
+	}
+	result = r
+	if o.Since < result.Since {
+		result.Since = o.Since
+	}
+	if o.Until > result.Until {
+		result.Until = o.Until
+	}
+	return result, true
+}
+
+// String returns the Range in the format "<interval>:<since>..<until>", which is the
+// complement to ParseRange.
+func (r Range) String() string {
+	return r.Interval.String() + ":" +
+		strconv.FormatInt(int64(r.Since), 10) + ".." +
+		strconv.FormatInt(int64(r.Until), 10)
+}
+
+func (r Range) MarshalText() (text []byte, err error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Range) UnmarshalText(text []byte) (err error) {
+	*r, err = ParseRange(string(text))
+	return err
+}
+
+// ParseRange parses a string representing a Range in the format
+// "<interval>:<since>..<until>", for example "1min:1234..1240".
+//
+// The values allowed for "<interval>" are defined by interval.Parse(). The values for
+// "<since>" and "<until>" must be parseable by strconv.ParseInt().
+func ParseRange(v string) (rng Range, err error) {
+	var since, until int64
+	var ps string
+
+	i := strings.IndexByte(v, ':')
+	if i < 0 {
+		goto fail
+	}
+	rng.Interval, err = Parse(v[:i])
+	if err != nil {
+		goto fail
+	}
+
+	ps = v[i+1:]
+	i = strings.Index(ps, "..")
+	if i < 0 {
+		goto fail
+	}
+	since, err = strconv.ParseInt(ps[:i], 10, 64)
+	if err != nil {
+		goto fail
+	}
+	until, err = strconv.ParseInt(ps[i+2:], 10, 64)
+	if err != nil {
+		goto fail
+	}
+	rng.Since, rng.Until = Period(since), Period(until)
+	return rng, nil
+
+fail:
+	return Range{}, fmt.Errorf("interval: invalid range %q; expected format '1min:1234..1240'", v)
+}
+
+// RangeIter iterates over the Moments in a Range. See Range.Iter().
+type RangeIter struct {
+	rng Range
+	cur Period
+}
+
+// Next advances the iterator to the next Moment, returning false when there are no
+// more Moments in the Range.
+func (ri *RangeIter) Next() bool {
+	if ri.cur+1 >= ri.rng.Until {
+		ri.cur = ri.rng.Until
+		return false
+	}
+	ri.cur++
+	return true
+}
+
+// Moment returns the current Moment. It is only valid after a call to Next() has
+// returned true.
+func (ri *RangeIter) Moment() Moment {
+	return Moment{Interval: ri.rng.Interval, Period: ri.cur}
+}
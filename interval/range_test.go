@@ -0,0 +1,171 @@
+package interval
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNewRange(t *testing.T) {
+	for idx, tc := range []struct {
+		intvl        Interval
+		since, until string
+		ex           Range
+	}{
+		{Of1Hour, "2020-01-01T00:00:00Z", "2020-01-01T02:00:00Z", Range{Of1Hour, 438288, 438290}},
+		{Of1Hour, "2020-01-01T00:30:00Z", "2020-01-01T02:00:00Z", Range{Of1Hour, 438288, 438290}},
+		{Of1Hour, "2020-01-01T00:30:00Z", "2020-01-01T02:30:00Z", Range{Of1Hour, 438288, 438291}},
+		{Of1Hour, "2020-01-01T00:30:00Z", "2020-01-01T00:30:00Z", Range{Of1Hour, 438288, 438288}},
+		{Of1Hour, "2020-01-01T00:30:00Z", "2019-01-01T00:00:00Z", Range{Of1Hour, 438288, 438288}},
+		{Of1Month, "2020-01-15T00:00:00Z", "2020-03-01T00:00:00Z", Range{Of1Month, 600, 602}},
+		{Of1Month, "2020-01-15T00:00:00Z", "2020-03-02T00:00:00Z", Range{Of1Month, 600, 603}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result := NewRange(tc.intvl, tm(tc.since), tm(tc.until))
+			if result != tc.ex {
+				t.Fatal(result, "!=", tc.ex)
+			}
+		})
+	}
+}
+
+func TestRangeLenContains(t *testing.T) {
+	rng := Range{Of1Minute, 10, 15}
+	if rng.Len() != 5 {
+		t.Fatal(rng.Len())
+	}
+	for p, ex := range map[Period]bool{9: false, 10: true, 14: true, 15: false} {
+		if rng.Contains(p) != ex {
+			t.Fatal(p, "!=", ex)
+		}
+	}
+
+	empty := Range{Of1Minute, 10, 5}
+	if empty.Len() != 0 || !empty.IsEmpty() || empty.Contains(7) {
+		t.Fatal()
+	}
+}
+
+func TestRangeIteration(t *testing.T) {
+	rng := Range{Of1Minute, -2, 2}
+	ex := []Moment{{Of1Minute, -2}, {Of1Minute, -1}, {Of1Minute, 0}, {Of1Minute, 1}}
+
+	var each []Moment
+	rng.Each(func(m Moment) bool { each = append(each, m); return true })
+	if !reflect.DeepEqual(each, ex) {
+		t.Fatal(each, "!=", ex)
+	}
+
+	var iterd []Moment
+	iter := rng.Iter()
+	for iter.Next() {
+		iterd = append(iterd, iter.Moment())
+	}
+	if !reflect.DeepEqual(iterd, ex) {
+		t.Fatal(iterd, "!=", ex)
+	}
+	if iter.Next() {
+		t.Fatal()
+	}
+
+	if moments := rng.Moments(); !reflect.DeepEqual(moments, ex) {
+		t.Fatal(moments, "!=", ex)
+	}
+
+	var stopped []Moment
+	rng.Each(func(m Moment) bool { stopped = append(stopped, m); return len(stopped) < 2 })
+	if !reflect.DeepEqual(stopped, ex[:2]) {
+		t.Fatal(stopped, "!=", ex[:2])
+	}
+
+	if (Range{Of1Minute, 2, 2}).Iter().Next() {
+		t.Fatal()
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	for idx, tc := range []struct {
+		in Range
+		n  int
+		ex []Range
+	}{
+		{Range{Of1Day, 0, 4}, 2, []Range{{Of1Day, 0, 2}, {Of1Day, 2, 4}}},
+		{Range{Of1Day, 0, 5}, 2, []Range{{Of1Day, 0, 3}, {Of1Day, 3, 5}}},
+		{Range{Of1Day, 0, 7}, 3, []Range{{Of1Day, 0, 3}, {Of1Day, 3, 5}, {Of1Day, 5, 7}}},
+		{Range{Of1Day, 0, 2}, 3, []Range{{Of1Day, 0, 1}, {Of1Day, 1, 2}}},
+		{Range{Of1Day, 0, 2}, 1, []Range{{Of1Day, 0, 2}}},
+		{Range{Of1Day, 0, 2}, 0, nil},
+		{Range{Of1Day, 0, 0}, 2, nil},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result := tc.in.Split(tc.n)
+			if !reflect.DeepEqual(result, tc.ex) {
+				t.Fatal(result, "!=", tc.ex)
+			}
+		})
+	}
+}
+
+func TestRangeIntersectUnion(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b    Range
+		isect   Range
+		isectOK bool
+		union   Range
+		unionOK bool
+	}{
+		{Range{Of1Day, 0, 4}, Range{Of1Day, 2, 6}, Range{Of1Day, 2, 4}, true, Range{Of1Day, 0, 6}, true},
+		{Range{Of1Day, 2, 6}, Range{Of1Day, 0, 4}, Range{Of1Day, 2, 4}, true, Range{Of1Day, 0, 6}, true},
+		{Range{Of1Day, 0, 6}, Range{Of1Day, 2, 4}, Range{Of1Day, 2, 4}, true, Range{Of1Day, 0, 6}, true},
+		{Range{Of1Day, 0, 2}, Range{Of1Day, 2, 4}, Range{}, false, Range{Of1Day, 0, 4}, true},
+		{Range{Of1Day, 0, 2}, Range{Of1Day, 3, 4}, Range{}, false, Range{}, false},
+		{Range{Of1Day, 0, 2}, Range{Of1Day, 5, 5}, Range{}, false, Range{Of1Day, 0, 2}, true},
+		{Range{Of1Day, 0, 2}, Range{Of1Hour, 0, 2}, Range{}, false, Range{}, false},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			isect, ok := tc.a.Intersect(tc.b)
+			if ok != tc.isectOK || isect != tc.isect {
+				t.Fatal("intersect", isect, ok, "!=", tc.isect, tc.isectOK)
+			}
+			union, ok := tc.a.Union(tc.b)
+			if ok != tc.unionOK || union != tc.union {
+				t.Fatal("union", union, ok, "!=", tc.union, tc.unionOK)
+			}
+		})
+	}
+}
+
+func TestRangeMarshal(t *testing.T) {
+	for idx, tc := range []struct {
+		in Range
+		ex string
+	}{
+		{Range{Of1Minute, 1234, 1240}, "1min:1234..1240"},
+		{Range{Raw(3, Week), -10, -2}, "3wk:-10..-2"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			bts, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(bts) != `"`+tc.ex+`"` {
+				t.Fatal(string(bts), "!=", tc.ex)
+			}
+
+			var out Range
+			if err := json.Unmarshal(bts, &out); err != nil {
+				t.Fatal(err)
+			}
+			if out != tc.in {
+				t.Fatal(out, "!=", tc.in)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "1min", "1min:1", "1min:1..", "1min:..1", "1x:1..2"} {
+		if _, err := ParseRange(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
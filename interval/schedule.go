@@ -0,0 +1,227 @@
+package interval
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shabbyrobe/golib/times"
+)
+
+// Schedule combines an Interval with an offset from the start of each Period,
+// for example "1d+9h" fires daily at 09:00, "1wk+2d" fires weekly at midnight
+// on Wednesday, and "1mo+14d+9h+30min" fires monthly at 09:30 on the 15th.
+//
+// Offsets are applied to the wall clock in Location, so "1d+9h" will fire at
+// 09:00 even on days where a daylight saving transition occurs. Period
+// boundaries for Day, Week, Month, Quarter and Year Intervals are local
+// midnights in Location (see Interval.PeriodIn). If Location is nil, UTC is
+// used.
+//
+// Location is not included in the text representation of a Schedule.
+type Schedule struct {
+	Interval Interval
+	Offset   []Interval
+	Location *time.Location
+}
+
+// NewSchedule returns a Schedule that fires at each offset from the start of
+// each Period of intvl. NewSchedule panics if intvl has a zero quantity, as
+// such a Schedule never fires.
+func NewSchedule(intvl Interval, offset ...Interval) Schedule {
+	if intvl.Qty() == 0 {
+		panic(fmt.Errorf("interval: schedule interval must not be zero"))
+	}
+	return Schedule{Interval: intvl, Offset: offset}
+}
+
+// MustParseSchedule is like ParseSchedule, but panics if s can not be parsed.
+func MustParseSchedule(s string) Schedule {
+	sched, err := ParseSchedule(s)
+	if err != nil {
+		panic(err)
+	}
+	return sched
+}
+
+// ParseSchedule parses a Schedule from an Interval followed by zero or more
+// offsets, separated by '+'. Each part must be parseable by interval.Parse:
+//
+//	"1d+9h"          == daily at 09:00
+//	"1wk+2d"         == weekly at midnight on Wednesday
+//	"1mo+14d+9h"     == monthly at 09:00 on the 15th
+//	"15min+5min"     == at 5, 20, 35 and 50 minutes past each hour
+//
+func ParseSchedule(s string) (sched Schedule, err error) {
+	parts := strings.Split(s, "+")
+	sched.Interval, err = Parse(parts[0])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("interval: invalid schedule %q: %w", s, err)
+	}
+	if sched.Interval.Qty() == 0 {
+		return Schedule{}, fmt.Errorf("interval: invalid schedule %q: interval must not be zero", s)
+	}
+	for _, part := range parts[1:] {
+		off, err := Parse(part)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("interval: invalid schedule %q offset: %w", s, err)
+		}
+		sched.Offset = append(sched.Offset, off)
+	}
+	return sched, nil
+}
+
+// String returns the Schedule in the format accepted by ParseSchedule.
+func (s Schedule) String() string {
+	var sb strings.Builder
+	sb.WriteString(s.Interval.String())
+	for _, off := range s.Offset {
+		sb.WriteByte('+')
+		sb.WriteString(off.String())
+	}
+	return sb.String()
+}
+
+func (s Schedule) MarshalText() (text []byte, err error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Schedule) UnmarshalText(text []byte) (err error) {
+	loc := s.Location
+	*s, err = ParseSchedule(string(text))
+	s.Location = loc
+	return err
+}
+
+// In returns a copy of the Schedule that fires in loc.
+func (s Schedule) In(loc *time.Location) Schedule {
+	s.Location = loc
+	return s
+}
+
+func (s Schedule) loc() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// fire returns the firing time for Period p.
+func (s Schedule) fire(p Period) time.Time {
+	start := s.Interval.TimeIn(p, s.loc())
+
+	y, mo, d := start.Date()
+	h, mi, sec := start.Clock()
+	ns := start.Nanosecond()
+
+	for _, off := range s.Offset {
+		qty := int(off.Qty())
+		switch off.Unit() {
+		case Microsecond:
+			ns += qty * int(time.Microsecond)
+		case Millisecond:
+			ns += qty * int(time.Millisecond)
+		case Second:
+			sec += qty
+		case Minute:
+			mi += qty
+		case Hour:
+			h += qty
+		case Day:
+			d += qty
+		case Week:
+			d += qty * 7
+		case Month:
+			mo += time.Month(qty)
+		case Quarter:
+			mo += time.Month(qty * 3)
+		case Year:
+			y += qty
+		default:
+			panic(fmt.Errorf("unknown unit %d", off.Unit()))
+		}
+	}
+
+	return time.Date(y, mo, d, h, mi, sec, ns, start.Location())
+}
+
+// Next returns the first time the Schedule fires that is strictly after t. It
+// panics if the Schedule's Interval is zero.
+func (s Schedule) Next(t time.Time) time.Time {
+	p := s.Interval.PeriodIn(t, s.loc())
+	for s.fire(p).After(t) {
+		p--
+	}
+	for !s.fire(p).After(t) {
+		p++
+	}
+	return s.fire(p).In(t.Location())
+}
+
+// Prev returns the last time the Schedule fired that is strictly before t. It
+// panics if the Schedule's Interval is zero.
+func (s Schedule) Prev(t time.Time) time.Time {
+	p := s.Interval.PeriodIn(t, s.loc())
+	for s.fire(p).Before(t) {
+		p++
+	}
+	for !s.fire(p).Before(t) {
+		p--
+	}
+	return s.fire(p).In(t.Location())
+}
+
+// ScheduleTicker delivers the firing times of a Schedule on a channel, like a
+// time.Ticker. As with time.Ticker, ticks are dropped if the receiver is too
+// slow to read them.
+type ScheduleTicker struct {
+	C <-chan time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTicker returns a ScheduleTicker that sends the firing time on C each time
+// the Schedule fires according to clock. If clock is nil, times.SystemClock is
+// used.
+//
+// Stop the ticker to release its resources.
+func (s Schedule) NewTicker(clock times.Clock) *ScheduleTicker {
+	if clock == nil {
+		clock = times.SystemClock
+	}
+
+	c := make(chan time.Time, 1)
+	st := &ScheduleTicker{C: c, stop: make(chan struct{})}
+
+	go func() {
+		for {
+			now := clock.Now()
+			next := s.Next(now)
+
+			timer := clock.NewTimer(next.Sub(now))
+			select {
+			case <-timer.C():
+			case <-st.stop:
+				timer.Stop()
+				return
+			}
+
+			select {
+			case c <- next:
+			case <-st.stop:
+				return
+			default:
+			}
+		}
+	}()
+
+	return st
+}
+
+// Stop turns off the ticker. As with time.Ticker, Stop does not close C, and a
+// tick that was sent before Stop was called may still be waiting to be read.
+func (st *ScheduleTicker) Stop() {
+	st.stopOnce.Do(func() { close(st.stop) })
+}
@@ -0,0 +1,133 @@
+package interval
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shabbyrobe/golib/times"
+)
+
+func TestParseSchedule(t *testing.T) {
+	for idx, tc := range []struct {
+		in  string
+		out string
+	}{
+		{"1d+9h", "1d+9hr"},
+		{"1wk+2d", "1wk+2d"},
+		{"1mo+14d+9h+30min", "1mo+14d+9hr+30min"},
+		{"15min", "15min"},
+		{" 1 day + 9 hours ", "1d+9hr"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			sched, err := ParseSchedule(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sched.String() != tc.out {
+				t.Fatal(sched.String(), "!=", tc.out)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "+9h", "1d+", "1d+9m", "0d+9h"} {
+		if _, err := ParseSchedule(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
+
+func TestNewScheduleZeroPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("did not panic")
+		}
+	}()
+	NewSchedule(Raw(0, Day))
+}
+
+func TestScheduleMarshal(t *testing.T) {
+	var v struct{ Schedule Schedule }
+	if err := json.Unmarshal([]byte(`{"Schedule":"1d+9h"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Schedule.Interval != Of1Day || len(v.Schedule.Offset) != 1 || v.Schedule.Offset[0] != Raw(9, Hour) {
+		t.Fatal(v.Schedule)
+	}
+	bts, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bts) != `{"Schedule":"1d+9hr"}` {
+		t.Fatal(string(bts))
+	}
+}
+
+func TestScheduleNextPrev(t *testing.T) {
+	syd := loadLocation(t, "Australia/Sydney")
+
+	for idx, tc := range []struct {
+		sched Schedule
+		in    time.Time
+		next  time.Time
+		prev  time.Time
+	}{
+		{MustParseSchedule("1d+9h"), tm("2020-01-01T08:00:00Z"), tm("2020-01-01T09:00:00Z"), tm("2019-12-31T09:00:00Z")},
+		{MustParseSchedule("1d+9h"), tm("2020-01-01T09:00:00Z"), tm("2020-01-02T09:00:00Z"), tm("2019-12-31T09:00:00Z")},
+		{MustParseSchedule("1d+9h"), tm("2020-01-01T10:00:00Z"), tm("2020-01-02T09:00:00Z"), tm("2020-01-01T09:00:00Z")},
+		{MustParseSchedule("1wk+2d"), tm("2020-01-01T10:00:00Z"), tm("2020-01-08T00:00:00Z"), tm("2020-01-01T00:00:00Z")},
+		{MustParseSchedule("15min+5min"), tm("2020-01-01T10:05:00Z"), tm("2020-01-01T10:20:00Z"), tm("2020-01-01T09:50:00Z")},
+		{MustParseSchedule("1mo+14d+9h"), tm("2020-02-20T00:00:00Z"), tm("2020-03-15T09:00:00Z"), tm("2020-02-15T09:00:00Z")},
+
+		// Offsets larger than the interval still produce ordered firing times:
+		{MustParseSchedule("1d+30h"), tm("2020-01-01T05:00:00Z"), tm("2020-01-01T06:00:00Z"), tm("2019-12-31T06:00:00Z")},
+
+		// Sydney DST ends 2020-04-05 03:00 -> 02:00; 09:00 wall clock is preserved:
+		{MustParseSchedule("1d+9h").In(syd), time.Date(2020, 4, 4, 10, 0, 0, 0, syd),
+			time.Date(2020, 4, 5, 9, 0, 0, 0, syd), time.Date(2020, 4, 4, 9, 0, 0, 0, syd)},
+		{MustParseSchedule("1d+9h").In(syd), time.Date(2020, 4, 5, 10, 0, 0, 0, syd),
+			time.Date(2020, 4, 6, 9, 0, 0, 0, syd), time.Date(2020, 4, 5, 9, 0, 0, 0, syd)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			next := tc.sched.Next(tc.in)
+			if !next.Equal(tc.next) {
+				t.Fatal("next", next, "!=", tc.next)
+			}
+			prev := tc.sched.Prev(tc.in)
+			if !prev.Equal(tc.prev) {
+				t.Fatal("prev", prev, "!=", tc.prev)
+			}
+		})
+	}
+}
+
+func TestScheduleTicker(t *testing.T) {
+	clock := times.NewFakeClock(tm("2020-01-01T08:00:00Z"))
+	ticker := MustParseSchedule("1d+9h").NewTicker(clock)
+	defer ticker.Stop()
+
+	for _, ex := range []time.Time{
+		tm("2020-01-01T09:00:00Z"),
+		tm("2020-01-02T09:00:00Z"),
+		tm("2020-01-03T09:00:00Z"),
+	} {
+		clock.BlockUntil(1)
+		clock.Set(ex)
+		select {
+		case tick := <-ticker.C:
+			if !tick.Equal(ex) {
+				t.Fatal(tick, "!=", ex)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for", ex)
+		}
+	}
+}
+
+func TestIntervalNextFrom(t *testing.T) {
+	clock := times.NewFakeClock(tm("2020-01-01T08:30:00Z"))
+	if next := Of1Hour.NextFrom(clock); !next.Equal(tm("2020-01-01T09:00:00Z")) {
+		t.Fatal(next)
+	}
+}
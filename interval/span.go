@@ -0,0 +1,113 @@
+package interval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func NewSpan(intvl Interval, num int64) Span {
+	return Span{Interval: intvl, Num: num}
+}
+
+func MustParseSpan(span string) Span {
+	s, err := ParseSpan(span)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ParseSpan parses a Span from a string representation of the number of intervals
+// as an integer, followed by an 'x', followed by an interval as accepted by
+// interval.Parse, for example:
+//
+//	"3x15min" == interval.Span{interval.Raw(15, interval.Minute), 3}
+//	"96x30min" == interval.Span{interval.Raw(30, interval.Minute), 96}
+//
+// If the "<num>x" prefix is omitted, Num is 1:
+//
+//	"15min" == interval.Span{interval.Raw(15, interval.Minute), 1}
+//
+func ParseSpan(span string) (Span, error) {
+	span = strings.TrimSpace(span)
+
+	num := int64(1)
+	intvl := span
+
+	if idx := strings.IndexAny(span, "xX"); idx >= 0 {
+		numStr := strings.TrimSpace(span[:idx])
+		if numStr == "" {
+			return Span{}, fmt.Errorf("interval: invalid span %q; expected format '3x15min'", span)
+		}
+		for _, c := range numStr {
+			if c < '0' || c > '9' {
+				return Span{}, fmt.Errorf("interval: invalid span %q; expected format '3x15min'", span)
+			}
+		}
+		var err error
+		num, err = strconv.ParseInt(numStr, 10, 64)
+		if err != nil {
+			return Span{}, err
+		}
+		intvl = span[idx+1:]
+	}
+
+	iv, err := Parse(intvl)
+	if err != nil {
+		return Span{}, err
+	}
+	return Span{Interval: iv, Num: num}, nil
+}
+
+// String returns the Span in the format "<num>x<interval>", which is the complement
+// to ParseSpan.
+func (s Span) String() string {
+	return strconv.FormatInt(s.Num, 10) + "x" + s.Interval.String()
+}
+
+func (s Span) IsZero() bool { return s.Num == 0 || s.Interval.IsZero() }
+
+// Duration returns the length of the Span, using the length of the Interval at the
+// Unix epoch. See Interval.Duration for caveats.
+func (s Span) Duration() time.Duration {
+	return time.Duration(s.Num) * s.Interval.Duration()
+}
+
+// DurationAt returns the length of the Span that starts at the Period containing
+// 'at'.
+func (s Span) DurationAt(at time.Time) time.Duration {
+	return s.End(at).Sub(s.Interval.Start(at))
+}
+
+// End returns the exclusive end of the Span that begins with the Period that
+// contains start.
+//
+// For example, "3x15min" starting at 2020-01-01T00:10Z will end at
+// 2020-01-01T00:45Z.
+func (s Span) End(start time.Time) time.Time {
+	return s.Interval.Time(s.Interval.Period(start)+Period(s.Num), start.Location())
+}
+
+// Range returns the Range of Periods covered by the Span that begins with the Period
+// that contains start.
+func (s Span) Range(start time.Time) Range {
+	since := s.Interval.Period(start)
+	return Range{Interval: s.Interval, Since: since, Until: since + Period(s.Num)}
+}
+
+func (s Span) MarshalText() (text []byte, err error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Span) UnmarshalText(text []byte) (err error) {
+	*s, err = ParseSpan(string(text))
+	return err
+}
+
+// Spans returns the Span of this Interval that covers the half-open span of time
+// [from, to). Partial Periods at either end are counted as whole Periods.
+func (i Interval) Spans(from, to time.Time) Span {
+	return Span{Interval: i, Num: NewRange(i, from, to).Len()}
+}
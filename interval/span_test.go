@@ -0,0 +1,124 @@
+package interval
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseSpan(t *testing.T) {
+	for idx, tc := range []struct {
+		in       string
+		expected Span
+	}{
+		{"3x15min", Span{Raw(15, Minute), 3}},
+		{" 3x15min ", Span{Raw(15, Minute), 3}},
+		{"3 x 15 min", Span{Raw(15, Minute), 3}},
+		{"96x30min", Span{Raw(30, Minute), 96}},
+		{"0x1d", Span{Of1Day, 0}},
+		{"1X1wk", Span{Of1Week, 1}},
+		{"15min", Span{Raw(15, Minute), 1}},
+	} {
+		t.Run(fmt.Sprintf("valid/%d", idx), func(t *testing.T) {
+			result := MustParseSpan(tc.in)
+			if result != tc.expected {
+				t.Fatal(result, "!=", tc.expected)
+			}
+		})
+	}
+
+	for idx, tc := range []string{"", "x15min", "-1x15min", "3x", "3x1m", "ax15min", "3x15minx2"} {
+		t.Run(fmt.Sprintf("invalid/%d", idx), func(t *testing.T) {
+			if _, err := ParseSpan(tc); err == nil {
+				t.Fatal(tc, "did not fail")
+			}
+		})
+	}
+}
+
+func TestSpanMarshal(t *testing.T) {
+	for idx, tc := range []struct {
+		in       Span
+		expected string
+	}{
+		{Span{Raw(15, Minute), 3}, "3x15min"},
+		{Span{Of1Day, 1}, "1x1d"},
+		{Span{Raw(2, Month), 12}, "12x2mo"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if tc.in.String() != tc.expected {
+				t.Fatal(tc.in.String(), "!=", tc.expected)
+			}
+			bts, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out Span
+			if err := json.Unmarshal(bts, &out); err != nil {
+				t.Fatal(err)
+			}
+			if out != tc.in {
+				t.Fatal(out, "!=", tc.in)
+			}
+		})
+	}
+}
+
+func TestSpanDuration(t *testing.T) {
+	s := Span{Raw(30, Minute), 96}
+	if s.Duration() != 48*time.Hour {
+		t.Fatal(s.Duration())
+	}
+
+	s = Span{Of1Month, 2}
+	if dur := s.DurationAt(tm("2020-01-15T00:00:00Z")); dur != (31+29)*24*time.Hour {
+		t.Fatal(dur)
+	}
+	if dur := s.DurationAt(tm("2021-01-15T00:00:00Z")); dur != (31+28)*24*time.Hour {
+		t.Fatal(dur)
+	}
+}
+
+func TestSpanEnd(t *testing.T) {
+	for idx, tc := range []struct {
+		in    Span
+		start time.Time
+		end   time.Time
+	}{
+		{Span{Raw(15, Minute), 3}, tm("2020-01-01T00:10:00Z"), tm("2020-01-01T00:45:00Z")},
+		{Span{Raw(15, Minute), 3}, tm("2020-01-01T00:15:00Z"), tm("2020-01-01T01:00:00Z")},
+		{Span{Of1Month, 2}, tm("2020-01-15T00:00:00Z"), tm("2020-03-01T00:00:00Z")},
+		{Span{Of1Day, 0}, tm("2020-01-15T12:00:00Z"), tm("2020-01-15T00:00:00Z")},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			end := tc.in.End(tc.start)
+			if !end.Equal(tc.end) {
+				t.Fatal(end, "!=", tc.end)
+			}
+			rng := tc.in.Range(tc.start)
+			if rng.Len() != tc.in.Num || !rng.UntilTime(nil).Equal(tc.end) {
+				t.Fatal(rng)
+			}
+		})
+	}
+}
+
+func TestIntervalSpans(t *testing.T) {
+	for idx, tc := range []struct {
+		in       Interval
+		from, to time.Time
+		expected Span
+	}{
+		{Raw(30, Minute), tm("2020-01-01T00:00:00Z"), tm("2020-01-03T00:00:00Z"), Span{Raw(30, Minute), 96}},
+		{Raw(30, Minute), tm("2020-01-01T00:10:00Z"), tm("2020-01-01T01:10:00Z"), Span{Raw(30, Minute), 3}},
+		{Of1Day, tm("2020-01-01T00:00:00Z"), tm("2020-01-01T00:00:00Z"), Span{Of1Day, 0}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result := tc.in.Spans(tc.from, tc.to)
+			if result != tc.expected {
+				t.Fatal(result, "!=", tc.expected)
+			}
+		})
+	}
+}
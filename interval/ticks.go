@@ -0,0 +1,197 @@
+package interval
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// TickLevel identifies whether a Tick is a major or a minor tick.
+type TickLevel int
+
+const (
+	TickMajor TickLevel = iota
+	TickMinor
+)
+
+// Tick is a single point on a chart axis created by Ticks.
+type Tick struct {
+	Time  time.Time
+	Level TickLevel
+
+	// Period is the Period of the Tick in the Axis's Major or Minor Interval,
+	// depending on Level.
+	Period Period
+
+	// Label is only set for major ticks. The first label contains the full
+	// date and time; subsequent labels only contain the parts that differ
+	// from the previous major tick (see Interval.FormatAfterIn). Labels use
+	// the local calendar date of Time, in the Axis's location.
+	Label string
+}
+
+// Axis contains the Ticks for a chart axis.
+type Axis struct {
+	Major Interval
+
+	// Minor is zero if no human friendly Interval divides Major cleanly.
+	Minor Interval
+
+	// Ticks contains both major and minor ticks, sorted by Time.
+	Ticks []Tick
+}
+
+// Majors returns only the major ticks.
+func (a Axis) Majors() []Tick {
+	var out []Tick
+	for _, tick := range a.Ticks {
+		if tick.Level == TickMajor {
+			out = append(out, tick)
+		}
+	}
+	return out
+}
+
+// minorTicksPerMajor is the preferred number of minor subdivisions for each
+// major tick.
+const minorTicksPerMajor = 5
+
+// maxMinorTicksPerMajor is the upper limit of minor subdivisions for each
+// major tick, which allows a Week to be divided into Days.
+const maxMinorTicksPerMajor = 10
+
+// niceYears is used by Ticks if the range is too large for any of the
+// niceIntervals.
+var niceYears = []Qty{2, 5, 10, 20, 25, 50, 100, 200, 250}
+
+// Ticks finds a human friendly Interval that yields no more than maxTicks
+// major ticks between from and to (inclusive), and returns the ticks aligned
+// to the Periods of that Interval in loc. If loc is nil, UTC is used.
+//
+// Minor ticks are added between the major ticks using a smaller Interval that
+// divides the major Interval into (ideally) around 5 parts. maxTicks does not
+// include the minor ticks.
+//
+// If maxTicks is less than 1 or to is before from, the Axis is empty.
+func Ticks(from, to time.Time, maxTicks int, loc *time.Location) Axis {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if maxTicks < 1 || to.Before(from) {
+		return Axis{}
+	}
+
+	var axis Axis
+	for _, intvl := range niceIntervals {
+		if countTicks(intvl, from, to, loc) <= int64(maxTicks) {
+			axis.Major = intvl
+			break
+		}
+	}
+	if axis.Major == 0 {
+		axis.Major = Raw(MaxYear, Year)
+		for _, qty := range niceYears {
+			if intvl := Raw(qty, Year); countTicks(intvl, from, to, loc) <= int64(maxTicks) {
+				axis.Major = intvl
+				break
+			}
+		}
+	}
+	axis.Minor = minorInterval(axis.Major)
+
+	// Labels are formatted from the TimeIn of each tick rather than with
+	// FormatIn, which uses Interval.Time; in zones with a negative offset,
+	// Interval.Time would label a Day or Week tick with the previous day.
+	first, last := tickPeriods(axis.Major, from, to, loc)
+	majorTimes := make(map[int64]bool, last-first+1)
+	var prevTime time.Time
+	for p := first; p <= last; p++ {
+		tick := Tick{Time: axis.Major.TimeIn(p, loc), Level: TickMajor, Period: p}
+		if p == first {
+			tick.Label = axis.Major.formatTime(tick.Time, p)
+		} else {
+			tick.Label = axis.Major.formatAfter(tick.Time, prevTime)
+		}
+		axis.Ticks = append(axis.Ticks, tick)
+		majorTimes[tick.Time.UnixNano()] = true
+		prevTime = tick.Time
+	}
+
+	if axis.Minor != 0 {
+		first, last := tickPeriods(axis.Minor, from, to, loc)
+		for p := first; p <= last; p++ {
+			tm := axis.Minor.TimeIn(p, loc)
+			if !majorTimes[tm.UnixNano()] {
+				axis.Ticks = append(axis.Ticks, Tick{Time: tm, Level: TickMinor, Period: p})
+			}
+		}
+		sort.SliceStable(axis.Ticks, func(i, j int) bool {
+			return axis.Ticks[i].Time.Before(axis.Ticks[j].Time)
+		})
+	}
+
+	return axis
+}
+
+// tickPeriods returns the first and last Periods of intvl that start within
+// [from, to]. If there are none, last will be less than first.
+func tickPeriods(intvl Interval, from, to time.Time, loc *time.Location) (first, last Period) {
+	first = intvl.PeriodIn(from, loc)
+	if intvl.TimeIn(first, loc).Before(from) {
+		first++
+	}
+	last = intvl.PeriodIn(to, loc)
+	return first, last
+}
+
+func countTicks(intvl Interval, from, to time.Time, loc *time.Location) int64 {
+	first, last := tickPeriods(intvl, from, to, loc)
+	if last < first {
+		return 0
+	}
+	return int64(last-first) + 1
+}
+
+// minorInterval finds the human friendly Interval that divides major into the
+// number of parts closest to minorTicksPerMajor, preferring fewer parts in the
+// event of a tie. The division is based on the nominal size of each Unit, so
+// Days divide Weeks but not Months. If there is no such Interval, zero is
+// returned.
+func minorInterval(major Interval) (minor Interval) {
+	candidates := make([]Interval, 0, len(niceIntervals)+len(niceYears))
+	candidates = append(candidates, niceIntervals...)
+	for _, qty := range niceYears {
+		candidates = append(candidates, Raw(qty, Year))
+	}
+
+	bestDiff := math.MaxInt64
+	for i := len(candidates) - 1; i >= 0; i-- {
+		parts := nominalParts(major, candidates[i])
+		if parts < 2 || parts > maxMinorTicksPerMajor {
+			continue
+		}
+		diff := parts - minorTicksPerMajor
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < bestDiff {
+			bestDiff, minor = diff, candidates[i]
+		}
+	}
+	return minor
+}
+
+// nominalParts returns the number of times 'by' fits exactly into intvl, or
+// zero if it does not fit exactly.
+func nominalParts(intvl, by Interval) int {
+	var size, bySize int64
+	if months := unitMonths(intvl.Unit()); months > 0 {
+		size, bySize = months*int64(intvl.Qty()), unitMonths(by.Unit())*int64(by.Qty())
+	} else {
+		size, bySize = int64(unitNanos(intvl.Unit()))*int64(intvl.Qty()), int64(unitNanos(by.Unit()))*int64(by.Qty())
+	}
+	if bySize == 0 || size%bySize != 0 {
+		return 0
+	}
+	return int(size / bySize)
+}
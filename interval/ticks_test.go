@@ -0,0 +1,147 @@
+package interval
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTicks(t *testing.T) {
+	for idx, tc := range []struct {
+		from, to string
+		max      int
+		major    Interval
+		minor    Interval
+		labels   []string
+	}{
+		{"2020-01-01T00:00:00Z", "2020-01-02T00:00:00Z", 10, Of3Hours, Of30Minutes, []string{
+			"2020-01-01T00:00Z", "03:00", "06:00", "09:00", "12:00", "15:00", "18:00", "21:00", "02-Jan 00:00",
+		}},
+		{"2020-01-01T10:07:00Z", "2020-01-01T11:00:00Z", 6, Of10Minutes, Of2Minutes, []string{
+			"2020-01-01T10:10Z", "10:20", "10:30", "10:40", "10:50", "11:00",
+		}},
+		{"2020-01-01T00:00:00Z", "2020-01-01T00:00:01Z", 5, Of250Milliseconds, OfValid(50, Milliseconds), []string{
+			"2020-01-01T00:00:00.000Z", "00:00:00.250", "00:00:00.500", "00:00:00.750", "00:00:01",
+		}},
+		{"2020-01-01T00:00:00Z", "2021-01-01T00:00:00Z", 12, OfValid(2, Months), Of1Month, []string{
+			"2020-01-01Z", "01-Mar", "01-May", "01-Jul", "01-Sep", "01-Nov", "2021-01",
+		}},
+		{"1900-01-01T00:00:00Z", "2200-01-01T00:00:00Z", 10, Raw(50, Year), Raw(10, Year), []string{
+			"1920Z", "1970", "2020", "2070", "2120", "2170",
+		}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			axis := Ticks(tm(tc.from), tm(tc.to), tc.max, nil)
+			if axis.Major != tc.major {
+				t.Fatal("major", axis.Major, "!=", tc.major)
+			}
+			if axis.Minor != tc.minor {
+				t.Fatal("minor", axis.Minor, "!=", tc.minor)
+			}
+
+			var labels []string
+			for _, tick := range axis.Majors() {
+				labels = append(labels, tick.Label)
+			}
+			if !reflect.DeepEqual(labels, tc.labels) {
+				t.Fatal(labels, "!=", tc.labels)
+			}
+
+			var last time.Time
+			for i, tick := range axis.Ticks {
+				if tick.Time.Before(tm(tc.from)) || tick.Time.After(tm(tc.to)) {
+					t.Fatal("tick", tick.Time, "out of range")
+				}
+				if i > 0 && !tick.Time.After(last) {
+					t.Fatal("tick", tick.Time, "not after", last)
+				}
+				last = tick.Time
+			}
+		})
+	}
+}
+
+func TestTicksMinorCount(t *testing.T) {
+	axis := Ticks(tm("2020-01-01T00:00:00Z"), tm("2020-01-01T02:00:00Z"), 3, nil)
+	if axis.Major != Of1Hour || axis.Minor != Of15Minutes {
+		t.Fatal(axis.Major, axis.Minor)
+	}
+	if len(axis.Ticks) != 9 || len(axis.Majors()) != 3 {
+		t.Fatal(len(axis.Ticks), len(axis.Majors()))
+	}
+	for i, tick := range axis.Ticks {
+		level := TickMinor
+		if i%4 == 0 {
+			level = TickMajor
+		}
+		if tick.Level != level {
+			t.Fatal(i, tick.Level, "!=", level)
+		}
+		if level == TickMinor && tick.Label != "" {
+			t.Fatal(i, tick.Label)
+		}
+	}
+}
+
+func TestTicksLocation(t *testing.T) {
+	syd := loadLocation(t, "Australia/Sydney")
+	from := time.Date(2020, 4, 3, 0, 0, 0, 0, syd)
+	to := time.Date(2020, 4, 7, 0, 0, 0, 0, syd)
+
+	axis := Ticks(from, to, 5, syd)
+	if axis.Major != Of1Day {
+		t.Fatal(axis.Major)
+	}
+	majors := axis.Majors()
+	if len(majors) != 5 {
+		t.Fatal(len(majors))
+	}
+	for i, tick := range majors {
+		ex := time.Date(2020, 4, 3+i, 0, 0, 0, 0, syd)
+		if !tick.Time.Equal(ex) {
+			t.Fatal(tick.Time, "!=", ex)
+		}
+	}
+}
+
+func TestTicksLocationNegativeOffset(t *testing.T) {
+	nyc := loadLocation(t, "America/New_York")
+	for idx, tc := range []struct {
+		from, to time.Time
+		max      int
+		major    Interval
+		labels   []string
+	}{
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, nyc), time.Date(2024, 3, 4, 0, 0, 0, 0, nyc), 5, Of1Day, []string{
+			"2024-02-29-05:00", "01-Mar", "02-Mar", "03-Mar", "04-Mar",
+		}},
+		{time.Date(2024, 3, 4, 0, 0, 0, 0, nyc), time.Date(2024, 4, 29, 0, 0, 0, 0, nyc), 9, Of1Week, []string{
+			"2024-03-04-05:00", "2024-03-11", "2024-03-18", "2024-03-25",
+			"2024-04-01", "2024-04-08", "2024-04-15", "2024-04-22", "2024-04-29",
+		}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			axis := Ticks(tc.from, tc.to, tc.max, nyc)
+			if axis.Major != tc.major {
+				t.Fatal("major", axis.Major, "!=", tc.major)
+			}
+			var labels []string
+			for _, tick := range axis.Majors() {
+				labels = append(labels, tick.Label)
+			}
+			if !reflect.DeepEqual(labels, tc.labels) {
+				t.Fatal(labels, "!=", tc.labels)
+			}
+		})
+	}
+}
+
+func TestTicksEmpty(t *testing.T) {
+	if axis := Ticks(tm("2020-01-02T00:00:00Z"), tm("2020-01-01T00:00:00Z"), 5, nil); len(axis.Ticks) != 0 {
+		t.Fatal(axis)
+	}
+	if axis := Ticks(tm("2020-01-01T00:00:00Z"), tm("2020-01-02T00:00:00Z"), 0, nil); len(axis.Ticks) != 0 {
+		t.Fatal(axis)
+	}
+}
@@ -3,44 +3,56 @@ package interval
 import "fmt"
 
 // These must increase numerically as the durations they represent increase in
-// size. Unfortunately, intervals are not perfectly sortable as 24 months will
-// still come before 1 day. The Less function has a red hot go, but it's not
-// perfect either as it checks against a fixed date, but intervals can
-// represent different units of time at different dates (daylight savings, leap
-// seconds, etc).
+// size, with the exception of Quarter, which was added after Year and can't be
+// renumbered without breaking existing serialised Intervals. Use Units if you
+// need the units in ascending order of size.
+//
+// Unfortunately, this means Intervals are not sortable by value as 24 months
+// will still come before 1 day. Use Compare or the Intervals slice type to
+// sort Intervals, or CompareOver if the order must reflect a specific range of
+// dates (daylight savings, leap years, etc).
 const (
-	Second Unit = 9
-	Minute Unit = 10
-	Hour   Unit = 11
-	Day    Unit = 12
-	Week   Unit = 13
-	Month  Unit = 14
-	Year   Unit = 15
+	Microsecond Unit = 7
+	Millisecond Unit = 8
+	Second      Unit = 9
+	Minute      Unit = 10
+	Hour        Unit = 11
+	Day         Unit = 12
+	Week        Unit = 13
+	Month       Unit = 14
+	Year        Unit = 15
+	Quarter     Unit = 16
 
 	// This mistake happens so frequently there's no obvious reason not to
 	// support plurals, but there may be a non-obvious one. Including for now,
 	// will remove this comment if the plurals work without incident:
-	Seconds Unit = Second
-	Minutes Unit = Minute
-	Hours   Unit = Hour
-	Days    Unit = Day
-	Weeks   Unit = Week
-	Months  Unit = Month
-	Years   Unit = Year
+	Microseconds Unit = Microsecond
+	Milliseconds Unit = Millisecond
+	Seconds      Unit = Second
+	Minutes      Unit = Minute
+	Hours        Unit = Hour
+	Days         Unit = Day
+	Weeks        Unit = Week
+	Months       Unit = Month
+	Years        Unit = Year
+	Quarters     Unit = Quarter
 
 	// These must not exceed 255.
-	MaxSecond Qty = 60
-	MaxMinute Qty = 90
-	MaxHour   Qty = 48
-	MaxDay    Qty = 120
-	MaxWeek   Qty = 52
-	MaxMonth  Qty = 24
-	MaxYear   Qty = 255
+	MaxMicrosecond Qty = 250
+	MaxMillisecond Qty = 250
+	MaxSecond      Qty = 60
+	MaxMinute      Qty = 90
+	MaxHour        Qty = 48
+	MaxDay         Qty = 120
+	MaxWeek        Qty = 52
+	MaxMonth       Qty = 24
+	MaxYear        Qty = 255
+	MaxQuarter     Qty = 8
 )
 
 // Units contains all valid interval units in guaranteed ascending order.
 var Units = []Unit{
-	Second, Minute, Hour, Day, Week, Month, Year,
+	Microsecond, Millisecond, Second, Minute, Hour, Day, Week, Month, Quarter, Year,
 }
 
 var firstUnit, lastUnit Unit
@@ -56,6 +68,10 @@ func init() {
 
 func (p Unit) String() string {
 	switch p {
+	case Microsecond:
+		return "us"
+	case Millisecond:
+		return "ms"
 	case Second:
 		return "sec"
 	case Minute:
@@ -68,6 +84,8 @@ func (p Unit) String() string {
 		return "wk"
 	case Month:
 		return "mo"
+	case Quarter:
+		return "qtr"
 	case Year:
 		return "yr"
 	default:
@@ -77,6 +95,10 @@ func (p Unit) String() string {
 
 func (p Unit) MaxQty() Qty {
 	switch p {
+	case Microsecond:
+		return MaxMicrosecond
+	case Millisecond:
+		return MaxMillisecond
 	case Second:
 		return MaxSecond
 	case Minute:
@@ -89,6 +111,8 @@ func (p Unit) MaxQty() Qty {
 		return MaxWeek
 	case Month:
 		return MaxMonth
+	case Quarter:
+		return MaxQuarter
 	case Year:
 		return MaxYear
 	default:
@@ -12,7 +12,20 @@ import (
 //
 // If the limit is set to a non-zero Interval, the resulting interval will
 // never be less than this limit.
+//
+// The resulting interval will never be less than Of1Second. Use
+// DivideNicelySubsecond to allow smaller intervals.
 func DivideNicely(intvl Interval, n int, limit Interval) Interval {
+	return divideNicely(intvl, n, limit, Of1Second)
+}
+
+// DivideNicelySubsecond is like DivideNicely, but the resulting interval may
+// be as small as Of1Microsecond.
+func DivideNicelySubsecond(intvl Interval, n int, limit Interval) Interval {
+	return divideNicely(intvl, n, limit, Of1Microsecond)
+}
+
+func divideNicely(intvl Interval, n int, limit Interval, smallest Interval) Interval {
 	size := intvl.Time(1, nil).Sub(intvl.Time(0, nil))
 	partSize := size / time.Duration(n)
 
@@ -25,11 +38,15 @@ func DivideNicely(intvl Interval, n int, limit Interval) Interval {
 	if !limit.IsZero() {
 		limitDuration = limit.Duration()
 	}
+	smallestDuration := smallest.Duration()
 
-	result := Of1Second
+	result := smallest
 
 	var lastInterval Interval
 	for _, niceSize := range niceIntervalSizes {
+		if niceSize.duration < smallestDuration {
+			break
+		}
 		if niceSize.duration < limitDuration {
 			result = lastInterval
 			break
@@ -74,8 +91,15 @@ func Find(duration time.Duration) Interval {
 	return FindAt(duration, intervalRefTime)
 }
 
+// FindSubsecond is like Find, but may return an Interval smaller than a
+// second.
+func FindSubsecond(duration time.Duration) Interval {
+	return FindSubsecondAt(duration, intervalRefTime)
+}
+
 // FindAt will find the smallest interval that encapsulates the duration, as
-// observed at the provided time.
+// observed at the provided time. The result is never smaller than Of1Second;
+// use FindSubsecondAt to allow smaller intervals.
 //
 // Currently, FindAt is rather naive. It will first search by Unit, then work
 // out how many of that unit to use. This may change at some point to attempt
@@ -90,14 +114,26 @@ func Find(duration time.Duration) Interval {
 //	FindAt(86401 * time.Second) == Raw(25, Hours)
 //
 func FindAt(duration time.Duration, at time.Time) Interval {
+	return findAt(duration, at, Second)
+}
+
+// FindSubsecondAt is like FindAt, but may return an Interval as small as
+// Of1Microsecond.
+func FindSubsecondAt(duration time.Duration, at time.Time) Interval {
+	return findAt(duration, at, Microsecond)
+}
+
+func findAt(duration time.Duration, at time.Time, smallest Unit) Interval {
 	if duration < 0 {
 		duration = -duration
 	}
 
-	var foundUnit = Seconds
+	var foundUnit = smallest
 	var foundDuration time.Duration
 
-	for unit := Seconds; unit <= Years; unit++ {
+	// Quarter is deliberately excluded here as it is numerically greater than
+	// Year; anything a Quarter can represent, Months can represent too.
+	for unit := smallest; unit <= Year; unit++ {
 		checkInterval := Raw(1, unit)
 		unitDuration := checkInterval.DurationAt(at)
 		if unitDuration > duration {
@@ -108,17 +144,30 @@ func FindAt(duration time.Duration, at time.Time) Interval {
 
 	if foundDuration == 0 || duration <= foundDuration {
 		return Raw(1, foundUnit)
+	}
 
-	} else {
-		// Integer division that 'truncates' up rather than down:
-		qty := Qty((duration-1)/foundDuration + 1)
+	// Integer division that 'truncates' up rather than down:
+	qty := (duration-1)/foundDuration + 1
 
-		return Raw(qty, foundUnit)
+	// The sub-second units can't represent a whole second's worth of
+	// themselves, so if we overflow we need to step up to the next unit:
+	if (foundUnit == Microsecond || foundUnit == Millisecond) && qty > time.Duration(foundUnit.MaxQty()) {
+		foundUnit++
+		foundDuration = Raw(1, foundUnit).DurationAt(at)
+		qty = (duration-1)/foundDuration + 1
 	}
+
+	return Raw(Qty(qty), foundUnit)
 }
 
 // MUST be storted, otherwise panic!!
 var niceIntervals = []Interval{
+	Of1Microsecond, OfValid(2, Microseconds), OfValid(5, Microseconds),
+	Of10Microseconds, OfValid(20, Microseconds), OfValid(50, Microseconds),
+	Of100Microseconds, OfValid(200, Microseconds),
+	Of1Millisecond, OfValid(2, Milliseconds), OfValid(5, Milliseconds),
+	Of10Milliseconds, OfValid(20, Milliseconds), OfValid(50, Milliseconds),
+	Of100Milliseconds, OfValid(200, Milliseconds), Of250Milliseconds,
 	Of1Second, Of2Seconds, Of5Seconds, Of10Seconds, Of15Seconds, Of30Seconds,
 	Of1Minute, Of2Minutes, Of5Minutes, Of10Minutes, Of15Minutes, Of30Minutes,
 	Of1Hour, Of2Hours, Of3Hours, Of4Hours, Of6Hours, Of8Hours, Of12Hours,
@@ -24,6 +24,8 @@ func TestDivideNicely(t *testing.T) {
 		{Of1Month, 5, 0, Raw(6, Days)},
 		{Of1Month, 7, 0, Raw(4, Days)},
 		{Raw(10, Years), 3, 0, Raw(3, Years)},
+		{Of1Second, 4, 0, Of1Second},
+		{Of1Minute, 1000, 0, Of1Second},
 	} {
 		t.Run(fmt.Sprintf("%s/%d==%s", tc.in, tc.by, tc.expected), func(t *testing.T) {
 			result := DivideNicely(tc.in, tc.by, tc.limit)
@@ -34,6 +36,32 @@ func TestDivideNicely(t *testing.T) {
 	}
 }
 
+func TestDivideNicelySubsecond(t *testing.T) {
+	for _, tc := range []struct {
+		in       Interval
+		by       int
+		limit    Interval
+		expected Interval
+	}{
+		{Of5Minutes, 10, 0, Of30Seconds},
+		{Of1Second, 4, 0, Of250Milliseconds},
+		{Of1Second, 10, 0, Of100Milliseconds},
+		{Of1Second, 10, Of250Milliseconds, Of250Milliseconds},
+		{Of1Millisecond, 10, 0, Of100Microseconds},
+		{Of1Microsecond, 10, 0, Of1Microsecond},
+		{Of1Minute, 1000, 0, OfValid(50, Milliseconds)},
+		{Of1Minute, 1000, Of1Second, Of1Second},
+		{Of5Seconds, 10, 0, Of250Milliseconds},
+	} {
+		t.Run(fmt.Sprintf("%s/%d==%s", tc.in, tc.by, tc.expected), func(t *testing.T) {
+			result := DivideNicelySubsecond(tc.in, tc.by, tc.limit)
+			if result != tc.expected {
+				t.Fatal(result)
+			}
+		})
+	}
+}
+
 func TestDivideNicelyFor(t *testing.T) {
 	for _, tc := range []struct {
 		in       Interval
@@ -72,6 +100,15 @@ func TestFind(t *testing.T) {
 		{24 * time.Hour * 3, Of3Days},
 		{(24 * time.Hour * 3) + (1 * time.Minute), Raw(4, Days)},
 
+		{59 * time.Second, Raw(59, Seconds)},
+		{61 * time.Second, Of2Minutes},
+		{89 * time.Minute, Of2Hours},
+		{100 * time.Minute, Of2Hours},
+		{25 * time.Hour, Of2Days},
+		{8 * 24 * time.Hour, Raw(2, Weeks)},
+		{40 * 24 * time.Hour, Raw(2, Months)},
+		{400 * 24 * time.Hour, Raw(2, Years)},
+
 		{0 * time.Second, Of1Second},
 		{1 * time.Nanosecond, Of1Second},
 		{999 * time.Millisecond, Of1Second},
@@ -85,3 +122,29 @@ func TestFind(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSubsecond(t *testing.T) {
+	for _, tc := range []struct {
+		dur      time.Duration
+		expected Interval
+	}{
+		{1 * time.Minute, Of1Minute},
+		{59 * time.Second, Raw(59, Seconds)},
+
+		{0 * time.Second, Of1Microsecond},
+		{1 * time.Nanosecond, Of1Microsecond},
+		{999 * time.Nanosecond, Of1Microsecond},
+		{1001 * time.Nanosecond, Raw(2, Microseconds)},
+		{250 * time.Millisecond, Of250Milliseconds},
+		{251 * time.Millisecond, Of1Second},
+		{999 * time.Millisecond, Of1Second},
+		{-1 * time.Millisecond, Of1Millisecond},
+	} {
+		t.Run(fmt.Sprintf("%s==%s", tc.dur, tc.expected), func(t *testing.T) {
+			result := FindSubsecond(tc.dur)
+			if result != tc.expected {
+				t.Fatal(result)
+			}
+		})
+	}
+}
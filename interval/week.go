@@ -0,0 +1,99 @@
+package interval
+
+import (
+	"time"
+
+	"github.com/shabbyrobe/golib/times"
+)
+
+// Weekly wraps an Interval so that Week Periods start on FirstDay rather
+// than on Monday. For all other Units, Weekly behaves exactly like the
+// wrapped Interval.
+//
+// Note that the zero value for FirstDay is time.Sunday, not time.Monday.
+// Week boundaries are midnights in t's location, so for Week Units, Periods
+// returned by a Weekly are not interchangeable with Periods returned by the
+// wrapped Interval. If FirstDay is time.Monday, they are the same as the
+// Periods returned by Interval.PeriodIn in t's location.
+type Weekly struct {
+	Interval Interval
+	FirstDay time.Weekday
+}
+
+// WeekStarting returns a Weekly for this Interval with weeks that start on
+// firstDay.
+func (i Interval) WeekStarting(firstDay time.Weekday) Weekly {
+	return Weekly{Interval: i, FirstDay: firstDay}
+}
+
+func (w Weekly) Period(t time.Time) Period {
+	if w.Interval.Unit() != Week {
+		return w.Interval.Period(t)
+	}
+	return Period(times.PeriodWeeksFrom(t, int(w.Interval.Qty()), w.FirstDay))
+}
+
+func (w Weekly) Time(p Period, loc *time.Location) time.Time {
+	if w.Interval.Unit() != Week {
+		return w.Interval.Time(p, loc)
+	}
+	return times.PeriodWeeksTimeFrom(int(p), int(w.Interval.Qty()), w.FirstDay, loc)
+}
+
+// Start returns the time that represents the inclusive start of the Period
+// that contains t.
+func (w Weekly) Start(t time.Time) time.Time {
+	return w.Time(w.Period(t), t.Location())
+}
+
+// Next returns the time at the beginning of the period that starts after the
+// period that encapsulates the passed-in time.
+func (w Weekly) Next(t time.Time) time.Time {
+	return w.Time(w.Period(t)+1, t.Location())
+}
+
+func (w Weekly) Format(p Period) string { return w.FormatIn(p, time.UTC) }
+
+func (w Weekly) FormatIn(p Period, in *time.Location) string {
+	if w.Interval.Unit() != Week {
+		return w.Interval.FormatIn(p, in)
+	}
+	return w.Time(p, in).Format("2006-01-02Z07:00")
+}
+
+func (w Weekly) FormatISOWeek(p Period) string { return w.FormatISOWeekIn(p, time.UTC) }
+
+// FormatISOWeekIn formats Week Periods as an ISO 8601 week, i.e. "2024-W07".
+// All other Units are formatted by Interval.FormatIn.
+//
+// ISO weeks always start on Monday, so if FirstDay is not Monday, the Period
+// is labelled with the ISO week that contains the majority of its days. For
+// Periods of more than one week, the first week is used.
+func (w Weekly) FormatISOWeekIn(p Period, in *time.Location) string {
+	if w.Interval.Unit() != Week {
+		return w.Interval.FormatIn(p, in)
+	}
+	tm := w.Time(p, in)
+	return times.FormatISOWeek(time.Date(tm.Year(), tm.Month(), tm.Day()+3, 0, 0, 0, 0, tm.Location()))
+}
+
+// FormatAfter is the Weekly equivalent of Interval.FormatAfter.
+func (w Weekly) FormatAfter(current Period, prev Period) string {
+	return w.FormatAfterIn(current, prev, time.UTC)
+}
+
+func (w Weekly) FormatAfterIn(current Period, prev Period, in *time.Location) string {
+	if w.Interval.Unit() != Week {
+		return w.Interval.FormatAfterIn(current, prev, in)
+	}
+	return w.Time(current, in).Format("2006-01-02")
+}
+
+func (i Interval) FormatISOWeek(p Period) string { return i.FormatISOWeekIn(p, time.UTC) }
+
+// FormatISOWeekIn formats Week Periods as an ISO 8601 week, i.e. "2024-W07".
+// All other Units are formatted by FormatIn. For Periods of more than one
+// week, the first week is used.
+func (i Interval) FormatISOWeekIn(p Period, in *time.Location) string {
+	return Weekly{Interval: i, FirstDay: time.Monday}.FormatISOWeekIn(p, in)
+}
@@ -0,0 +1,80 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWeekly(t *testing.T) {
+	for idx, tc := range []struct {
+		weekly Weekly
+		in     time.Time
+		start  time.Time
+		next   time.Time
+		iso    string
+	}{
+		{Of1Week.WeekStarting(time.Sunday), tm("2024-02-14T12:00:00Z"), tm("2024-02-11T00:00:00Z"), tm("2024-02-18T00:00:00Z"), "2024-W07"},
+		{Of1Week.WeekStarting(time.Sunday), tm("2024-02-11T00:00:00Z"), tm("2024-02-11T00:00:00Z"), tm("2024-02-18T00:00:00Z"), "2024-W07"},
+		{Of1Week.WeekStarting(time.Sunday), tm("2024-02-10T23:59:59Z"), tm("2024-02-04T00:00:00Z"), tm("2024-02-11T00:00:00Z"), "2024-W06"},
+		{Of1Week.WeekStarting(time.Monday), tm("2024-02-14T12:00:00Z"), tm("2024-02-12T00:00:00Z"), tm("2024-02-19T00:00:00Z"), "2024-W07"},
+		{Of1Week.WeekStarting(time.Monday), tm("2021-01-03T12:00:00Z"), tm("2020-12-28T00:00:00Z"), tm("2021-01-04T00:00:00Z"), "2020-W53"},
+		{Of1Week.WeekStarting(time.Saturday), tm("2024-02-14T12:00:00Z"), tm("2024-02-10T00:00:00Z"), tm("2024-02-17T00:00:00Z"), "2024-W07"},
+		{Raw(2, Week).WeekStarting(time.Sunday), tm("1970-01-14T00:00:00Z"), tm("1970-01-11T00:00:00Z"), tm("1970-01-25T00:00:00Z"), "1970-W03"},
+
+		// Other units are unaffected:
+		{Of1Day.WeekStarting(time.Sunday), tm("2024-02-14T12:00:00Z"), tm("2024-02-14T00:00:00Z"), tm("2024-02-15T00:00:00Z"), "2024-02-14Z"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			start := tc.weekly.Start(tc.in)
+			if !start.Equal(tc.start) {
+				t.Fatal("start", start, "!=", tc.start)
+			}
+			next := tc.weekly.Next(tc.in)
+			if !next.Equal(tc.next) {
+				t.Fatal("next", next, "!=", tc.next)
+			}
+			p := tc.weekly.Period(tc.in)
+			if tc.weekly.Period(start) != p || tc.weekly.Period(next) != p+1 {
+				t.Fatal("period", p)
+			}
+			if result := tc.weekly.FormatISOWeek(p); result != tc.iso {
+				t.Fatal("iso", result, "!=", tc.iso)
+			}
+		})
+	}
+}
+
+func TestWeeklyMondayMatchesPeriodIn(t *testing.T) {
+	w := Of1Week.WeekStarting(time.Monday)
+	for _, in := range []time.Time{
+		tm("1969-12-14T23:59:59Z"),
+		tm("1969-12-29T00:00:00Z"),
+		tm("2024-02-14T12:00:00Z"),
+		tm("2024-02-12T00:00:00+10:00"),
+	} {
+		if p := Of1Week.PeriodIn(in, in.Location()); w.Period(in) != p {
+			t.Fatal(in, w.Period(in), "!=", p)
+		}
+	}
+}
+
+func TestFormatISOWeek(t *testing.T) {
+	for _, tc := range []struct {
+		intvl  Interval
+		period Period
+		out    string
+	}{
+		{Of1Week, 0, "1970-W01"},
+		{Of1Week, 10, "1970-W11"},
+		{Of1Week, Of1Week.Period(tm("2024-02-14T00:00:00Z")), "2024-W07"},
+		{Of1Day, 10, "1970-01-11Z"},
+	} {
+		t.Run("", func(t *testing.T) {
+			result := tc.intvl.FormatISOWeek(tc.period)
+			if result != tc.out {
+				t.Fatal(result)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package iotools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AtomicFileWriter writes to a temporary file in the same directory as the
+// destination, then replaces the destination with it when Commit is called,
+// so readers of the destination see either the old contents or the new
+// contents, but never a partial write.
+//
+// Always Close the writer; if Commit has not been called, Close removes the
+// temporary file and leaves the destination untouched:
+//
+//	w, err := NewAtomicFileWriter(path, 0644)
+//	if err != nil {
+//		return err
+//	}
+//	defer w.Close()
+//	if _, err := w.Write(data); err != nil {
+//		return err
+//	}
+//	return w.Commit()
+//
+type AtomicFileWriter struct {
+	f      *os.File
+	path   string
+	closed bool
+
+	setTimes     bool
+	atime, mtime time.Time
+}
+
+// NewAtomicFileWriter creates the temporary file for an AtomicFileWriter that
+// will replace path. perm is applied to the file as is, without the umask.
+func NewAtomicFileWriter(path string, perm os.FileMode) (*AtomicFileWriter, error) {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	f, err := os.CreateTemp(dir, "."+base+".tmp*")
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return &AtomicFileWriter{f: f, path: path}, nil
+}
+
+func (w *AtomicFileWriter) Write(p []byte) (n int, err error) {
+	if w.closed {
+		return 0, errAlreadyClosed(0)
+	}
+	return w.f.Write(p)
+}
+
+// Chtimes sets the access and modification times to apply to the file when
+// it is committed. Without it, the times are those of the last Write.
+func (w *AtomicFileWriter) Chtimes(atime, mtime time.Time) {
+	w.setTimes, w.atime, w.mtime = true, atime, mtime
+}
+
+// Commit flushes the temporary file to stable storage, renames it over the
+// destination, then flushes the directory. If Commit fails, the temporary file
+// is removed and the destination is left untouched, unless the failure was in
+// flushing the directory after the rename.
+func (w *AtomicFileWriter) Commit() (rerr error) {
+	if w.closed {
+		return errAlreadyClosed(0)
+	}
+	w.closed = true
+
+	tmp := w.f.Name()
+	defer func() {
+		if rerr != nil {
+			os.Remove(tmp)
+		}
+	}()
+
+	if err := w.f.Sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	if w.setTimes {
+		if err := os.Chtimes(tmp, w.atime, w.mtime); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmp, w.path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(w.path)); err != nil {
+		return fmt.Errorf("iotools: atomic file %q written, but sync of directory failed: %w", w.path, err)
+	}
+	return nil
+}
+
+// Close discards the temporary file if Commit has not been called. It is safe
+// to call Close after Commit.
+func (w *AtomicFileWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	cerr := w.f.Close()
+	if err := os.Remove(w.f.Name()); err != nil {
+		return err
+	}
+	return cerr
+}
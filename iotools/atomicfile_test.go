@@ -0,0 +1,96 @@
+package iotools
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAtomicFileWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Abandoned writes leave the destination untouched:
+	w, err := NewAtomicFileWriter(path, 0640)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("partial")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	assertFileContents(t, path, "old")
+	assertDirEntries(t, dir, 1)
+
+	w, err = NewAtomicFileWriter(path, 0640)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if _, err := w.Write([]byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	w.Chtimes(mtime, mtime)
+	assertFileContents(t, path, "old")
+
+	if err := w.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Commit(); err == nil {
+		t.Fatal()
+	}
+	if _, err := w.Write([]byte("more")); err == nil {
+		t.Fatal()
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertFileContents(t, path, "new")
+	assertDirEntries(t, dir, 1)
+	st, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !st.ModTime().Equal(mtime) {
+		t.Fatal(st.ModTime())
+	}
+	if st.Mode().Perm() != 0640 {
+		t.Fatal(st.Mode())
+	}
+}
+
+func assertFileContents(t *testing.T, path string, expected string) {
+	t.Helper()
+	out, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Fatal(string(out), "!=", expected)
+	}
+}
+
+func assertDirEntries(t *testing.T, dir string, expected int) {
+	t.Helper()
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != expected {
+		t.Fatal(len(entries), "!=", expected)
+	}
+}
@@ -0,0 +1,167 @@
+package iotools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+// aLongTimeAgo is a deadline in the past, used to unblock a pending read or
+// write when a context is done.
+var aLongTimeAgo = time.Unix(1, 0)
+
+type readDeadliner interface {
+	SetReadDeadline(t time.Time) error
+}
+
+type writeDeadliner interface {
+	SetWriteDeadline(t time.Time) error
+}
+
+// ContextReader wraps an io.Reader so that Read fails with ctx.Err() once ctx
+// is done.
+//
+// If the underlying reader supports read deadlines (for example a net.Conn,
+// or an *os.File that refers to a pipe), a Read that is blocked when ctx is
+// done is interrupted, and ctx's deadline, if any, is applied to each Read.
+// Otherwise ctx is only checked before each Read, so a Read that blocks
+// forever can not be cancelled.
+//
+// NewContextReader clears any read deadline already set on the underlying
+// reader.
+type ContextReader struct {
+	ctx context.Context
+	rdr io.Reader
+	dl  readDeadliner
+}
+
+func NewContextReader(ctx context.Context, rdr io.Reader) *ContextReader {
+	cr := &ContextReader{ctx: ctx, rdr: rdr}
+	if dl, ok := rdr.(readDeadliner); ok && ctx.Done() != nil {
+		if err := dl.SetReadDeadline(time.Time{}); err == nil {
+			cr.dl = dl
+		}
+	}
+	return cr
+}
+
+func (cr *ContextReader) Read(p []byte) (n int, err error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if cr.dl == nil {
+		return cr.rdr.Read(p)
+	}
+
+	deadline, hasDeadline := cr.ctx.Deadline()
+	if hasDeadline {
+		cr.dl.SetReadDeadline(deadline)
+	}
+	stop := watchContext(cr.ctx, func() { cr.dl.SetReadDeadline(aLongTimeAgo) })
+	n, err = cr.rdr.Read(p)
+	stop()
+
+	if err != nil && err != io.EOF {
+		return n, contextIOError(cr.ctx, hasDeadline, err)
+	}
+	return n, err
+}
+
+// ContextWriter wraps an io.Writer so that Write fails with ctx.Err() once ctx
+// is done. Write deadlines are used in the same way as ContextReader uses read
+// deadlines.
+type ContextWriter struct {
+	ctx context.Context
+	w   io.Writer
+	dl  writeDeadliner
+}
+
+func NewContextWriter(ctx context.Context, w io.Writer) *ContextWriter {
+	cw := &ContextWriter{ctx: ctx, w: w}
+	if dl, ok := w.(writeDeadliner); ok && ctx.Done() != nil {
+		if err := dl.SetWriteDeadline(time.Time{}); err == nil {
+			cw.dl = dl
+		}
+	}
+	return cw
+}
+
+func (cw *ContextWriter) Write(p []byte) (n int, err error) {
+	if err := cw.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if cw.dl == nil {
+		return cw.w.Write(p)
+	}
+
+	deadline, hasDeadline := cw.ctx.Deadline()
+	if hasDeadline {
+		cw.dl.SetWriteDeadline(deadline)
+	}
+	stop := watchContext(cw.ctx, func() { cw.dl.SetWriteDeadline(aLongTimeAgo) })
+	n, err = cw.w.Write(p)
+	stop()
+
+	if err != nil {
+		return n, contextIOError(cw.ctx, hasDeadline, err)
+	}
+	return n, err
+}
+
+// contextIOError replaces err with the context's error if ctx is done. The OS
+// timer for ctx's deadline can fire before ctx's own timer does, so if the
+// deadline was applied and err is a deadline error, ctx is treated as expired
+// even if ctx.Err() is still nil.
+func contextIOError(ctx context.Context, hasDeadline bool, err error) error {
+	if cerr := ctx.Err(); cerr != nil {
+		return cerr
+	}
+	if hasDeadline && errors.Is(err, os.ErrDeadlineExceeded) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+// watchContext calls fn if ctx is done before stop is called. stop waits for
+// fn to return if it has been called.
+func watchContext(ctx context.Context, fn func()) (stop func()) {
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			fn()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+	}
+}
+
+// CopyContext copies from src to dst until either EOF is reached on src, an
+// error occurs, or ctx is done, in which case ctx.Err() is returned. See
+// ContextReader for how promptly a blocked copy is interrupted.
+func CopyContext(ctx context.Context, dst io.Writer, src io.Reader) (written int64, err error) {
+	buf := make([]byte, 32*1024)
+
+	// The wrappers hide io.WriterTo and io.ReaderFrom from io.CopyBuffer, so
+	// ctx is checked between each chunk.
+	written, err = io.CopyBuffer(NewContextWriter(ctx, dst), NewContextReader(ctx, src), buf)
+	if err != nil {
+		if cerr := ctx.Err(); cerr != nil {
+			return written, cerr
+		}
+	}
+	return written, err
+}
+
+// CopyFileContext is like CopyFile, but stops when ctx is done. If the copy
+// does not complete, the partially written destination file is removed.
+func CopyFileContext(ctx context.Context, from, to string) (rerr error) {
+	return CopyFileWithOptions(ctx, from, to, CopyFileOptions{})
+}
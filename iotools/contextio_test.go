@@ -0,0 +1,125 @@
+package iotools
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextReaderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cr := NewContextReader(ctx, strings.NewReader("hello"))
+
+	buf := make([]byte, 2)
+	if n, err := cr.Read(buf); n != 2 || err != nil {
+		t.Fatal(n, err)
+	}
+	cancel()
+	if n, err := cr.Read(buf); n != 0 || err != context.Canceled {
+		t.Fatal(n, err)
+	}
+}
+
+func TestContextReaderInterruptsPipe(t *testing.T) {
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+	defer pw.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = NewContextReader(ctx, pr).Read(make([]byte, 10))
+	if err != context.Canceled {
+		t.Fatal(err)
+	}
+	if since := time.Since(start); since > 5*time.Second {
+		t.Fatal(since)
+	}
+}
+
+func TestContextReaderDeadline(t *testing.T) {
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+	defer pw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := NewContextReader(ctx, pr).Read(make([]byte, 10)); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+}
+
+func TestContextWriterInterruptsConn(t *testing.T) {
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	// Nothing reads from c2, so this blocks until ctx is done:
+	if _, err := NewContextWriter(ctx, c1).Write([]byte("yep")); err != context.Canceled {
+		t.Fatal(err)
+	}
+}
+
+func TestCopyContext(t *testing.T) {
+	in := bytes.Repeat([]byte("abcdefgh"), 10000)
+
+	var out bytes.Buffer
+	n, err := CopyContext(context.Background(), &out, bytes.NewReader(in))
+	if err != nil || n != int64(len(in)) || !bytes.Equal(in, out.Bytes()) {
+		t.Fatal(n, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	out.Reset()
+	if n, err := CopyContext(ctx, &out, bytes.NewReader(in)); n != 0 || err != context.Canceled {
+		t.Fatal(n, err)
+	}
+}
+
+func TestCopyFileContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	from, to := filepath.Join(dir, "from"), filepath.Join(dir, "to")
+	if err := ioutil.WriteFile(from, []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CopyFileContext(context.Background(), from, to); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := ioutil.ReadFile(to); err != nil || string(out) != "hello" {
+		t.Fatal(string(out), err)
+	}
+	os.Remove(to)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := CopyFileContext(ctx, from, to); err != context.Canceled {
+		t.Fatal(err)
+	}
+	if ok, _ := Exists(to); ok {
+		t.Fatal("partial file not removed")
+	}
+}
@@ -1,56 +1,155 @@
 package iotools
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 func CopyFile(from, to string) (rerr error) {
+	_, err := copyFile(context.Background(), from, to, CopyFileOptions{})
+	return err
+}
+
+// CopyFileOptions controls the behaviour of CopyFileWithOptions.
+type CopyFileOptions struct {
+	// PreserveMode copies the permission bits of the source file to the
+	// destination, without applying the umask.
+	PreserveMode bool
+
+	// PreserveModTime copies the modification time of the source file to the
+	// destination. The access time is set to the time of the copy.
+	PreserveModTime bool
+
+	// Atomic writes the destination using an AtomicFileWriter, so it is
+	// either replaced completely or left untouched. Without PreserveMode, an
+	// existing destination keeps its permission bits and a new one is
+	// created with 0644.
+	Atomic bool
+}
+
+// CopyFileWithOptions copies from to to, stopping if ctx is done. If the copy
+// does not complete and the destination did not exist before, the partially
+// written destination file is removed. With opts.Atomic, a partial copy never
+// appears.
+func CopyFileWithOptions(ctx context.Context, from, to string, opts CopyFileOptions) error {
+	created, err := copyFile(ctx, from, to, opts)
+	if err != nil && created {
+		os.Remove(to)
+	}
+	return err
+}
+
+// copyFile copies from to to, stopping if ctx is done. created reports
+// whether a non-atomic destination file was created by this call, and so may
+// need to be cleaned up if there is an error.
+func copyFile(ctx context.Context, from, to string, opts CopyFileOptions) (created bool, rerr error) {
 	in, err := os.Open(from)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer in.Close()
 
 	st, err := in.Stat()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	expected := st.Size()
+	mode := st.Mode() & preservedModeBits
+
+	if opts.Atomic {
+		perm := os.FileMode(0644)
+		if opts.PreserveMode {
+			perm = mode
+		} else if dst, err := os.Stat(to); err == nil {
+			perm = dst.Mode() & preservedModeBits
+		}
+
+		out, err := NewAtomicFileWriter(to, perm)
+		if err != nil {
+			return false, err
+		}
+		defer out.Close()
+
+		if err := copyFileData(ctx, out, in, expected); err != nil {
+			return false, err
+		}
+		if opts.PreserveModTime {
+			out.Chtimes(time.Now(), st.ModTime())
+		}
+		return false, out.Commit()
+	}
 
-	out, err := os.Create(to)
+	// Try to create the file exclusively first so we know whether it's ours
+	// to remove if the copy fails:
+	out, err := os.OpenFile(to, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+	if err == nil {
+		created = true
+	} else if os.IsExist(err) {
+		out, err = os.Create(to)
+	}
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	defer func() {
-		cerr := out.Close()
-		if rerr == nil && cerr != nil {
-			rerr = cerr
+	if opts.PreserveMode {
+		if err := out.Chmod(mode); err != nil {
+			out.Close()
+			return created, err
 		}
-	}()
+	}
+
+	if err := copyFileData(ctx, out, in, expected); err != nil {
+		out.Close()
+		return created, err
+	}
+	if err := out.Close(); err != nil {
+		return created, err
+	}
+
+	if opts.PreserveModTime {
+		if err := os.Chtimes(to, time.Now(), st.ModTime()); err != nil {
+			return created, err
+		}
+	}
 
-	n, err := io.Copy(out, in)
+	return created, nil
+}
+
+const preservedModeBits = os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky
+
+func copyFileData(ctx context.Context, out io.Writer, in io.Reader, expected int64) (err error) {
+	var n int64
+	if ctx.Done() == nil {
+		// io.Copy can use faster paths than CopyContext, like copy_file_range:
+		n, err = io.Copy(out, in)
+	} else {
+		n, err = CopyContext(ctx, out, in)
+	}
 	if err != nil {
 		return err
 	}
-
 	if n != expected {
 		return fmt.Errorf("iotools: copy expected %d bytes, but only copied %d", expected, n)
 	}
-
 	return nil
 }
 
-// MoveFile copies a file from one location to another, then removes the
-// original if the copy succeeded.
-//
-// It is useful for situations where it is known that os.Rename would produce
-// an "invalid cross-device link" error
+// MoveFile moves a file from one location to another. It tries os.Rename
+// first, and only if that fails because the locations are on different
+// devices does it copy the file (atomically, preserving its mode and
+// modification time) and then remove the original.
 func MoveFile(from, to string) (rerr error) {
-	if err := CopyFile(from, to); err != nil {
+	err := os.Rename(from, to)
+	if err == nil || !isCrossDeviceError(err) {
+		return err
+	}
+
+	opts := CopyFileOptions{PreserveMode: true, PreserveModTime: true, Atomic: true}
+	if err := CopyFileWithOptions(context.Background(), from, to, opts); err != nil {
 		return err
 	}
 	if err := os.Remove(from); err != nil {
@@ -0,0 +1,131 @@
+package iotools
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestCopyFileWithOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	from := filepath.Join(dir, "from")
+	if err := ioutil.WriteFile(from, []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(from, 0751); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(from, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	for idx, opts := range []CopyFileOptions{
+		{PreserveMode: true, PreserveModTime: true},
+		{PreserveMode: true, PreserveModTime: true, Atomic: true},
+	} {
+		to := filepath.Join(dir, "to")
+		if err := CopyFileWithOptions(context.Background(), from, to, opts); err != nil {
+			t.Fatal(idx, err)
+		}
+		assertFileContents(t, to, "hello")
+
+		st, err := os.Stat(to)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if runtime.GOOS != "windows" && st.Mode().Perm() != 0751 {
+			t.Fatal(idx, st.Mode())
+		}
+		if !st.ModTime().Equal(mtime) {
+			t.Fatal(idx, st.ModTime())
+		}
+		os.Remove(to)
+	}
+
+	// Atomic copies keep the mode of an existing destination:
+	to := filepath.Join(dir, "to")
+	if err := ioutil.WriteFile(to, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := CopyFileWithOptions(context.Background(), from, to, CopyFileOptions{Atomic: true}); err != nil {
+		t.Fatal(err)
+	}
+	assertFileContents(t, to, "hello")
+	if st, err := os.Stat(to); err != nil || (runtime.GOOS != "windows" && st.Mode().Perm() != 0600) {
+		t.Fatal(st.Mode(), err)
+	}
+
+	// Failed atomic copies leave the destination untouched:
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := ioutil.WriteFile(to, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := CopyFileWithOptions(ctx, from, to, CopyFileOptions{Atomic: true}); err != context.Canceled {
+		t.Fatal(err)
+	}
+	assertFileContents(t, to, "old")
+	assertDirEntries(t, dir, 2)
+
+	// Failed non-atomic copies only remove the destination if they created it:
+	if err := CopyFileWithOptions(ctx, from, to, CopyFileOptions{}); err != context.Canceled {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(to); err != nil {
+		t.Fatal(err)
+	}
+	created := filepath.Join(dir, "created")
+	if err := CopyFileWithOptions(ctx, from, created, CopyFileOptions{}); err != context.Canceled {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	assertDirEntries(t, dir, 2)
+}
+
+func TestMoveFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	from, to := filepath.Join(dir, "from"), filepath.Join(dir, "to")
+	if err := ioutil.WriteFile(from, []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := MoveFile(from, to); err != nil {
+		t.Fatal(err)
+	}
+	assertFileContents(t, to, "hello")
+	if ok, _ := Exists(from); ok {
+		t.Fatal()
+	}
+
+	// Errors other than cross-device errors are not retried as a copy:
+	if err := MoveFile(from, to); !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+}
+
+func TestIsCrossDeviceError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+	err := &os.LinkError{Op: "rename", Old: "a", New: "b", Err: syscall.EXDEV}
+	if !isCrossDeviceError(err) || isCrossDeviceError(os.ErrNotExist) {
+		t.Fatal()
+	}
+}
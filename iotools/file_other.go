@@ -0,0 +1,29 @@
+//go:build !windows
+// +build !windows
+
+package iotools
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// syncDir flushes the directory entry changes in dir, such as a rename, to
+// stable storage.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	serr := d.Sync()
+	cerr := d.Close()
+	if serr != nil {
+		return serr
+	}
+	return cerr
+}
+
+func isCrossDeviceError(err error) bool {
+	return errors.Is(err, syscall.EXDEV)
+}
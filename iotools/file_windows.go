@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+package iotools
+
+import (
+	"errors"
+	"syscall"
+)
+
+// syncDir does nothing on Windows, where directories can not be opened for
+// syncing and renames are flushed by the filesystem.
+func syncDir(dir string) error {
+	return nil
+}
+
+// errorNotSameDevice is ERROR_NOT_SAME_DEVICE, which os.Rename returns when
+// moving between volumes.
+const errorNotSameDevice syscall.Errno = 17
+
+func isCrossDeviceError(err error) bool {
+	return errors.Is(err, errorNotSameDevice)
+}
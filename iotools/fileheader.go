@@ -12,6 +12,9 @@ const fileHeaderLengthBytes = 4
 // the supplied byte array, if and only if the magic string is present at the
 // start.
 //
+// FileHeaderBytes only accepts v1 headers, as written by FileHeaderWrite. Use
+// FileHeaderBytesV2 to accept both v1 and v2 headers.
+//
 // This API is not stable.
 func FileHeaderBytes(magic string, bts []byte) (hdr []byte, n int, err error) {
 	magicLen := len(magic)
@@ -24,14 +27,22 @@ func FileHeaderBytes(magic string, bts []byte) (hdr []byte, n int, err error) {
 
 	for i := 0; i < magicLen; i++ {
 		if bts[i] != magic[i] {
-			err = fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, fileHeaderLengthBytes)
+			err = fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, magicLen)
 			return
 		}
 	}
 
-	hlen := binary.LittleEndian.Uint32(bts[expected:])
-	eu32 := uint32(expected)
-	return bts[eu32 : eu32+hlen], int(eu32 + hlen), nil
+	hlen := binary.LittleEndian.Uint32(bts[magicLen:])
+	if hlen == fileHeaderV2Marker {
+		err = errFileHeaderIsV2
+		return
+	}
+	if uint64(len(bts)) < uint64(expected)+uint64(hlen) {
+		err = fmt.Errorf("iotools: file header expected at least %d bytes, found %d", uint64(expected)+uint64(hlen), len(bts))
+		return
+	}
+	end := expected + int(hlen)
+	return bts[expected:end], end, nil
 }
 
 // FileHeaderRead reads the length-delimited header portion of a file from the
@@ -39,6 +50,9 @@ func FileHeaderBytes(magic string, bts []byte) (hdr []byte, n int, err error) {
 //
 // If an error occurs, all bytes read from the reader are returned as 'hdr'.
 //
+// FileHeaderRead only accepts v1 headers, as written by FileHeaderWrite. Use
+// FileHeaderReadV2 to accept both v1 and v2 headers.
+//
 // This API is not stable.
 func FileHeaderRead(magic string, rdr io.Reader) (hdr []byte, n int, err error) {
 	magicLen := len(magic)
@@ -56,11 +70,14 @@ func FileHeaderRead(magic string, rdr io.Reader) (hdr []byte, n int, err error)
 
 	for i := 0; i < magicLen; i++ {
 		if bts[i] != magic[i] {
-			return bts, n, fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, fileHeaderLengthBytes)
+			return bts, n, fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, magicLen)
 		}
 	}
 
 	hlen := binary.LittleEndian.Uint32(bts[magicLen:])
+	if hlen == fileHeaderV2Marker {
+		return bts, n, errFileHeaderIsV2
+	}
 	if hlen > 0 {
 		hdr = make([]byte, hlen)
 		rn, err = io.ReadFull(rdr, hdr)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/shabbyrobe/golib/iotools/bytewriter"
@@ -59,3 +60,147 @@ func TestFileHeaderRead(t *testing.T) {
 		t.Fatal(exp[12:], "!=", body)
 	}
 }
+
+func TestFileHeaderBytes(t *testing.T) {
+	in := []byte{
+		'a', 'b', 'c', 'd', // magic
+		0x4, 0x0, 0x0, 0x0, // uint32le hdr length
+		'h', 'd', 'r', '!', // hdr bytes
+		'b', 'o', 'd', 'y', // file body
+	}
+	hdr, n, err := FileHeaderBytes("abcd", in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 12 || !reflect.DeepEqual(in[8:12], hdr) {
+		t.Fatal(n, hdr)
+	}
+
+	if _, _, err := FileHeaderBytes("abcd", in[:10]); err == nil {
+		t.Fatal()
+	}
+	_, _, err = FileHeaderBytes("abce", in)
+	if err == nil || !strings.Contains(err.Error(), "first 4 bytes") {
+		t.Fatal(err)
+	}
+	_, _, err = FileHeaderBytes("abcdef", in)
+	if err == nil || !strings.Contains(err.Error(), "first 6 bytes") {
+		t.Fatal(err)
+	}
+}
+
+func TestFileHeaderV2RoundTrip(t *testing.T) {
+	in := &FileHeader{Version: 3, Flags: 0x5}
+	in.Set("name", "yep")
+	in.Set("data", []byte{1, 2, 3})
+	in.Set("size", uint32(1234))
+	in.Set("offset", -99)
+	in.Set("ok", true)
+	in.Set("ratio", 0.5)
+
+	var buf bytes.Buffer
+	n, err := FileHeaderWriteV2("abcd", &buf, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("body")
+	raw := append([]byte(nil), buf.Bytes()...)
+
+	expected := &FileHeader{
+		HeaderVersion: 2, Version: 3, Flags: 0x5,
+		Fields: map[string]interface{}{
+			"name": "yep", "data": []byte{1, 2, 3}, "size": uint64(1234),
+			"offset": int64(-99), "ok": true, "ratio": 0.5,
+		},
+	}
+
+	out, rn, err := FileHeaderReadV2("abcd", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rn != n || !reflect.DeepEqual(expected, out) {
+		t.Fatal(rn, n, out)
+	}
+	if rest := buf.String(); rest != "body" {
+		t.Fatal(rest)
+	}
+
+	out, bn, err := FileHeaderBytesV2("abcd", raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bn != n || !reflect.DeepEqual(expected, out) {
+		t.Fatal(bn, n, out)
+	}
+
+	if !out.HasFlags(0x4) || out.HasFlags(0x6) {
+		t.Fatal()
+	}
+	if v, ok := out.Uint("size"); !ok || v != 1234 {
+		t.Fatal(v, ok)
+	}
+	if v, ok := out.String("size"); ok {
+		t.Fatal(v)
+	}
+
+	if _, _, err := FileHeaderRead("abcd", bytes.NewReader(raw)); err != errFileHeaderIsV2 {
+		t.Fatal(err)
+	}
+	if _, _, err := FileHeaderBytes("abcd", raw); err != errFileHeaderIsV2 {
+		t.Fatal(err)
+	}
+}
+
+func TestFileHeaderV2ReadsV1(t *testing.T) {
+	in := []byte{'a', 'b', 'c', 'd', 0x2, 0x0, 0x0, 0x0, 'h', '!', 'b'}
+	expected := &FileHeader{HeaderVersion: 1, Raw: []byte{'h', '!'}}
+
+	out, n, err := FileHeaderReadV2("abcd", bytes.NewReader(in))
+	if err != nil || n != 10 || !reflect.DeepEqual(expected, out) {
+		t.Fatal(out, n, err)
+	}
+	out, n, err = FileHeaderBytesV2("abcd", in)
+	if err != nil || n != 10 || !reflect.DeepEqual(expected, out) {
+		t.Fatal(out, n, err)
+	}
+}
+
+func TestFileHeaderV2Corrupt(t *testing.T) {
+	hdr := &FileHeader{Version: 1}
+	hdr.Set("name", "yep")
+	raw, err := AppendFileHeaderV2(nil, "abcd", hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 4; i < len(raw); i++ {
+		if i >= 4 && i < 8 {
+			continue // Corrupting the marker turns the header into a v1 header.
+		}
+		bad := append([]byte(nil), raw...)
+		bad[i] ^= 0x10
+		if _, _, err := FileHeaderBytesV2("abcd", bad); err == nil {
+			t.Fatal(i)
+		}
+	}
+
+	if _, _, err := FileHeaderReadV2("abcd", bytes.NewReader(raw[:len(raw)-1])); err == nil {
+		t.Fatal()
+	}
+	if _, _, err := FileHeaderBytesV2("abcd", raw[:len(raw)-1]); err == nil {
+		t.Fatal()
+	}
+
+	hdr.Set("bad", struct{}{})
+	if _, err := AppendFileHeaderV2(nil, "abcd", hdr); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestFileHeaderV2ReadsV1TooLarge(t *testing.T) {
+	in := []byte{'a', 'b', 'c', 'd', 0xFE, 0xFF, 0xFF, 0xFF, 'h', '!'}
+	out, n, err := FileHeaderReadV2("abcd", bytes.NewReader(in))
+	if err == nil || out != nil || n != 8 {
+		t.Fatal(out, n, err)
+	}
+}
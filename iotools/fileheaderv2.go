@@ -0,0 +1,431 @@
+package iotools
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"sort"
+)
+
+// A v2 file header looks like this (all integers are little-endian):
+//
+//	magic      [len(magic)]byte
+//	marker     uint32  // 0xFFFFFFFF, which is never a valid v1 length
+//	hdrVersion uint8   // 2
+//	version    uint16  // FileHeader.Version
+//	flags      uint32  // FileHeader.Flags
+//	fieldsLen  uint32
+//	fields     [fieldsLen]byte
+//	crc        uint32  // CRC32C of everything from magic to the end of fields
+//
+// Each field is encoded as a uvarint key length, the key, a uint8 type, a
+// uvarint value length, then the value. Unknown types are skipped when
+// reading, so new types can be added without breaking old readers.
+
+const (
+	fileHeaderV2Marker    = 0xFFFFFFFF
+	fileHeaderV2Version   = 2
+	fileHeaderV2FixedSize = 1 + 2 + 4 + 4
+	fileHeaderCRCSize     = 4
+)
+
+// FileHeaderMaxFieldsSize is the largest v2 field section, or v1 header, that
+// FileHeaderReadV2 will allocate memory for.
+const FileHeaderMaxFieldsSize = 1 << 24
+
+const (
+	fileHeaderFieldBytes byte = 1 + iota
+	fileHeaderFieldString
+	fileHeaderFieldUint
+	fileHeaderFieldInt
+	fileHeaderFieldBool
+	fileHeaderFieldFloat
+)
+
+var (
+	ErrFileHeaderChecksum = fmt.Errorf("iotools: file header checksum mismatch")
+
+	errFileHeaderIsV2 = fmt.Errorf("iotools: file header is v2; use FileHeaderReadV2 or FileHeaderBytesV2")
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// FileHeader is a versioned file header with feature flags and typed fields,
+// as written by FileHeaderWriteV2.
+//
+// This API is not stable.
+type FileHeader struct {
+	// HeaderVersion is 1 if the header was written by FileHeaderWrite, or 2 if
+	// it was written by FileHeaderWriteV2. It is ignored when writing.
+	HeaderVersion int
+
+	// Version is the version of the file format that follows the header.
+	Version uint16
+
+	// Flags are feature flags for the file format that follows the header.
+	Flags uint32
+
+	// Fields may contain values of type []byte, string, bool, float64, or any
+	// integer type. Signed integers are read back as int64 and unsigned
+	// integers as uint64.
+	Fields map[string]interface{}
+
+	// Raw contains the header bytes of a v1 header. It is ignored when
+	// writing.
+	Raw []byte
+}
+
+func (h *FileHeader) HasFlags(flags uint32) bool { return h.Flags&flags == flags }
+
+// Set sets the field called key to value. See FileHeader.Fields for the types
+// that can be written.
+func (h *FileHeader) Set(key string, value interface{}) {
+	if h.Fields == nil {
+		h.Fields = make(map[string]interface{})
+	}
+	h.Fields[key] = value
+}
+
+func (h *FileHeader) Bytes(key string) (v []byte, ok bool) {
+	v, ok = h.Fields[key].([]byte)
+	return v, ok
+}
+
+func (h *FileHeader) String(key string) (v string, ok bool) {
+	v, ok = h.Fields[key].(string)
+	return v, ok
+}
+
+func (h *FileHeader) Uint(key string) (v uint64, ok bool) {
+	v, ok = h.Fields[key].(uint64)
+	return v, ok
+}
+
+func (h *FileHeader) Int(key string) (v int64, ok bool) {
+	v, ok = h.Fields[key].(int64)
+	return v, ok
+}
+
+func (h *FileHeader) Bool(key string) (v bool, ok bool) {
+	v, ok = h.Fields[key].(bool)
+	return v, ok
+}
+
+func (h *FileHeader) Float(key string) (v float64, ok bool) {
+	v, ok = h.Fields[key].(float64)
+	return v, ok
+}
+
+// AppendFileHeaderV2 appends a v2 header, preceded by the magic string, to
+// buf.
+func AppendFileHeaderV2(buf []byte, magic string, hdr *FileHeader) ([]byte, error) {
+	start := len(buf)
+	buf = append(buf, magic...)
+	buf = appendUint32LE(buf, fileHeaderV2Marker)
+	buf = append(buf, fileHeaderV2Version)
+	buf = append(buf, byte(hdr.Version), byte(hdr.Version>>8))
+	buf = appendUint32LE(buf, hdr.Flags)
+
+	lenAt := len(buf)
+	buf = appendUint32LE(buf, 0)
+
+	keys := make([]string, 0, len(hdr.Fields))
+	for k := range hdr.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var err error
+	for _, k := range keys {
+		if k == "" {
+			return buf[:start], fmt.Errorf("iotools: file header field key must not be empty")
+		}
+		buf = appendUvarint(buf, uint64(len(k)))
+		buf = append(buf, k...)
+		buf, err = appendFileHeaderValue(buf, hdr.Fields[k])
+		if err != nil {
+			return buf[:start], fmt.Errorf("iotools: file header field %q: %w", k, err)
+		}
+	}
+
+	fieldsLen := len(buf) - lenAt - 4
+	if fieldsLen > FileHeaderMaxFieldsSize {
+		return buf[:start], fmt.Errorf("iotools: file header fields too large: %d > %d", fieldsLen, FileHeaderMaxFieldsSize)
+	}
+	binary.LittleEndian.PutUint32(buf[lenAt:], uint32(fieldsLen))
+
+	buf = appendUint32LE(buf, crc32.Checksum(buf[start:], castagnoli))
+	return buf, nil
+}
+
+// FileHeaderWriteV2 writes a v2 header to an io.Writer, preceded by a magic
+// string to help identify the file.
+func FileHeaderWriteV2(magic string, w io.Writer, hdr *FileHeader) (n int, err error) {
+	buf, err := AppendFileHeaderV2(nil, magic, hdr)
+	if err != nil {
+		return 0, err
+	}
+	n, err = w.Write(buf)
+	if err != nil {
+		return n, err
+	} else if n != len(buf) {
+		return n, fmt.Errorf("iotools: file header short write")
+	}
+	return n, nil
+}
+
+// FileHeaderBytesV2 extracts a v1 or v2 file header from the supplied byte
+// array, if and only if the magic string is present at the start. n is the
+// number of bytes used by the header, including the magic string.
+func FileHeaderBytesV2(magic string, bts []byte) (hdr *FileHeader, n int, err error) {
+	magicLen := len(magic)
+	expected := magicLen + fileHeaderLengthBytes
+	if len(bts) < expected {
+		return nil, 0, fmt.Errorf("iotools: file header expected at least %d bytes, found %d", expected, len(bts))
+	}
+	if string(bts[:magicLen]) != magic {
+		return nil, 0, fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, magicLen)
+	}
+
+	if binary.LittleEndian.Uint32(bts[magicLen:]) != fileHeaderV2Marker {
+		raw, n, err := FileHeaderBytes(magic, bts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &FileHeader{HeaderVersion: 1, Raw: raw}, n, nil
+	}
+
+	fixedEnd := expected + fileHeaderV2FixedSize
+	if len(bts) < fixedEnd {
+		return nil, 0, fmt.Errorf("iotools: file header expected at least %d bytes, found %d", fixedEnd, len(bts))
+	}
+	fieldsLen := binary.LittleEndian.Uint32(bts[fixedEnd-4:])
+	if fieldsLen > FileHeaderMaxFieldsSize {
+		return nil, 0, fmt.Errorf("iotools: file header fields too large: %d > %d", fieldsLen, FileHeaderMaxFieldsSize)
+	}
+	end := fixedEnd + int(fieldsLen) + fileHeaderCRCSize
+	if len(bts) < end {
+		return nil, 0, fmt.Errorf("iotools: file header expected at least %d bytes, found %d", end, len(bts))
+	}
+	hdr, err = decodeFileHeaderV2(bts[:end], magicLen)
+	if err != nil {
+		return nil, 0, err
+	}
+	return hdr, end, nil
+}
+
+// FileHeaderReadV2 reads a v1 or v2 file header from the supplied io.Reader,
+// if and only if the magic string is present at the start. n is the number of
+// bytes read from rdr.
+func FileHeaderReadV2(magic string, rdr io.Reader) (hdr *FileHeader, n int, err error) {
+	magicLen := len(magic)
+	expected := magicLen + fileHeaderLengthBytes
+	bts := make([]byte, expected, expected+fileHeaderV2FixedSize)
+
+	rn, err := io.ReadFull(rdr, bts)
+	n += rn
+	if err != nil {
+		return nil, n, fmt.Errorf("iotools: file header short read preamble: %w", err)
+	}
+	if string(bts[:magicLen]) != magic {
+		return nil, n, fmt.Errorf("iotools: file header expected magic %q in first %d bytes of file", magic, magicLen)
+	}
+
+	hlen := binary.LittleEndian.Uint32(bts[magicLen:])
+	if hlen != fileHeaderV2Marker {
+		if hlen > FileHeaderMaxFieldsSize {
+			return nil, n, fmt.Errorf("iotools: file header too large: %d > %d", hlen, FileHeaderMaxFieldsSize)
+		}
+		raw := make([]byte, hlen)
+		rn, err = io.ReadFull(rdr, raw)
+		n += rn
+		if err != nil {
+			return nil, n, fmt.Errorf("iotools: file header short read: %w", err)
+		}
+		return &FileHeader{HeaderVersion: 1, Raw: raw}, n, nil
+	}
+
+	bts = bts[:expected+fileHeaderV2FixedSize]
+	rn, err = io.ReadFull(rdr, bts[expected:])
+	n += rn
+	if err != nil {
+		return nil, n, fmt.Errorf("iotools: file header short read: %w", err)
+	}
+	if v := bts[expected]; v != fileHeaderV2Version {
+		return nil, n, fmt.Errorf("iotools: file header version %d not supported", v)
+	}
+
+	fieldsLen := binary.LittleEndian.Uint32(bts[len(bts)-4:])
+	if fieldsLen > FileHeaderMaxFieldsSize {
+		return nil, n, fmt.Errorf("iotools: file header fields too large: %d > %d", fieldsLen, FileHeaderMaxFieldsSize)
+	}
+
+	fixedEnd := len(bts)
+	bts = append(bts, make([]byte, int(fieldsLen)+fileHeaderCRCSize)...)
+	rn, err = io.ReadFull(rdr, bts[fixedEnd:])
+	n += rn
+	if err != nil {
+		return nil, n, fmt.Errorf("iotools: file header short read: %w", err)
+	}
+
+	hdr, err = decodeFileHeaderV2(bts, magicLen)
+	return hdr, n, err
+}
+
+// decodeFileHeaderV2 decodes a complete v2 header, including the magic string
+// and the checksum.
+func decodeFileHeaderV2(bts []byte, magicLen int) (*FileHeader, error) {
+	crcAt := len(bts) - fileHeaderCRCSize
+	if crc32.Checksum(bts[:crcAt], castagnoli) != binary.LittleEndian.Uint32(bts[crcAt:]) {
+		return nil, ErrFileHeaderChecksum
+	}
+
+	fixed := bts[magicLen+fileHeaderLengthBytes:]
+	if fixed[0] != fileHeaderV2Version {
+		return nil, fmt.Errorf("iotools: file header version %d not supported", fixed[0])
+	}
+	hdr := &FileHeader{
+		HeaderVersion: fileHeaderV2Version,
+		Version:       binary.LittleEndian.Uint16(fixed[1:]),
+		Flags:         binary.LittleEndian.Uint32(fixed[3:]),
+	}
+
+	fields := bts[magicLen+fileHeaderLengthBytes+fileHeaderV2FixedSize : crcAt]
+	for len(fields) > 0 {
+		klen, sz := binary.Uvarint(fields)
+		if sz <= 0 || klen == 0 || klen > uint64(len(fields)-sz) {
+			return nil, fmt.Errorf("iotools: file header field key corrupt")
+		}
+		key := string(fields[sz : sz+int(klen)])
+		fields = fields[sz+int(klen):]
+
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("iotools: file header field %q missing type", key)
+		}
+		typ := fields[0]
+		vlen, sz := binary.Uvarint(fields[1:])
+		if sz <= 0 || vlen > uint64(len(fields)-1-sz) {
+			return nil, fmt.Errorf("iotools: file header field %q value corrupt", key)
+		}
+		raw := fields[1+sz : 1+sz+int(vlen)]
+		fields = fields[1+sz+int(vlen):]
+
+		value, ok, err := decodeFileHeaderValue(typ, raw)
+		if err != nil {
+			return nil, fmt.Errorf("iotools: file header field %q: %w", key, err)
+		} else if ok {
+			hdr.Set(key, value)
+		}
+	}
+
+	return hdr, nil
+}
+
+func appendFileHeaderValue(buf []byte, value interface{}) ([]byte, error) {
+	var typ byte
+	var raw []byte
+	var scratch [binary.MaxVarintLen64]byte
+
+	switch v := value.(type) {
+	case []byte:
+		typ, raw = fileHeaderFieldBytes, v
+	case string:
+		typ, raw = fileHeaderFieldString, []byte(v)
+	case bool:
+		typ, raw = fileHeaderFieldBool, []byte{0}
+		if v {
+			raw[0] = 1
+		}
+	case float64:
+		typ, raw = fileHeaderFieldFloat, scratch[:8]
+		binary.LittleEndian.PutUint64(raw, math.Float64bits(v))
+	case int, int8, int16, int32, int64:
+		typ = fileHeaderFieldInt
+		raw = scratch[:binary.PutVarint(scratch[:], toInt64(v))]
+	case uint, uint8, uint16, uint32, uint64:
+		typ = fileHeaderFieldUint
+		raw = scratch[:binary.PutUvarint(scratch[:], toUint64(v))]
+	default:
+		return buf, fmt.Errorf("unsupported type %T", value)
+	}
+
+	buf = append(buf, typ)
+	buf = appendUvarint(buf, uint64(len(raw)))
+	return append(buf, raw...), nil
+}
+
+// decodeFileHeaderValue returns ok == false for unknown types, which should be
+// skipped.
+func decodeFileHeaderValue(typ byte, raw []byte) (value interface{}, ok bool, err error) {
+	switch typ {
+	case fileHeaderFieldBytes:
+		return append([]byte{}, raw...), true, nil
+	case fileHeaderFieldString:
+		return string(raw), true, nil
+	case fileHeaderFieldBool:
+		if len(raw) != 1 || raw[0] > 1 {
+			return nil, false, fmt.Errorf("invalid bool")
+		}
+		return raw[0] == 1, true, nil
+	case fileHeaderFieldFloat:
+		if len(raw) != 8 {
+			return nil, false, fmt.Errorf("invalid float")
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(raw)), true, nil
+	case fileHeaderFieldInt:
+		v, sz := binary.Varint(raw)
+		if sz != len(raw) || sz <= 0 {
+			return nil, false, fmt.Errorf("invalid int")
+		}
+		return v, true, nil
+	case fileHeaderFieldUint:
+		v, sz := binary.Uvarint(raw)
+		if sz != len(raw) || sz <= 0 {
+			return nil, false, fmt.Errorf("invalid uint")
+		}
+		return v, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	default:
+		return v.(int64)
+	}
+}
+
+func toUint64(v interface{}) uint64 {
+	switch v := v.(type) {
+	case uint:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	default:
+		return v.(uint64)
+	}
+}
+
+func appendUint32LE(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	return append(buf, scratch[:binary.PutUvarint(scratch[:], v)]...)
+}
@@ -1,8 +1,10 @@
 package iotools
 
 import (
+	"bufio"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 )
 
@@ -162,3 +164,139 @@ again:
 	pr.bufPos += msgLen
 	return out, msgLen, nil
 }
+
+// MessageReader is implemented by the MessageReader* types.
+type MessageReader interface {
+	ReadNext() (out []byte, n int, err error)
+}
+
+var (
+	_ MessageReader = &MessageReaderBytePrefix{}
+	_ MessageReader = &MessageReaderShortPrefix{}
+	_ MessageReader = &MessageReaderUvarint{}
+)
+
+// DefaultMessageMaxSize is used by MessageReaderUvarint and
+// MessageWriterUvarint if the maxSize passed to the constructor is <= 0.
+const DefaultMessageMaxSize = 1 << 20
+
+var ErrMessageChecksum = fmt.Errorf("iotools: message checksum mismatch")
+
+// MessageReaderUvarint reads messages written by MessageWriterUvarint. Each
+// message is prefixed by its length as a uvarint and, if checksum is set,
+// followed by a little-endian CRC32C of the message. Unlike the other
+// MessageReaders, empty messages are returned rather than skipped.
+type MessageReaderUvarint struct {
+	rdr      *bufio.Reader
+	buf      []byte
+	maxSize  int
+	checksum bool
+}
+
+// NewMessageReaderUvarint returns a MessageReaderUvarint that fails with an
+// error if it finds a message larger than maxSize. If maxSize is <= 0,
+// DefaultMessageMaxSize is used. checksum must match the value passed to the
+// MessageWriterUvarint.
+func NewMessageReaderUvarint(rdr io.Reader, maxSize int, checksum bool) *MessageReaderUvarint {
+	if maxSize <= 0 {
+		maxSize = DefaultMessageMaxSize
+	}
+	br, ok := rdr.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(rdr)
+	}
+	return &MessageReaderUvarint{
+		rdr:      br,
+		maxSize:  maxSize,
+		checksum: checksum,
+	}
+}
+
+// ReadNext returns a slice containing the next message and the length of the
+// message. The memory returned is valid only until the next call to ReadNext.
+//
+// io.EOF is returned if there are no more messages. If the reader ends part
+// way through a message, the error wraps io.ErrUnexpectedEOF.
+func (pr *MessageReaderUvarint) ReadNext() (out []byte, n int, err error) {
+	msgLen, err := binary.ReadUvarint(pr.rdr)
+	if err == io.EOF {
+		return nil, 0, io.EOF // EOF is used to allow users to terminate the loop
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("iotools: messagereader read failed: %w", err)
+	}
+	if msgLen > uint64(pr.maxSize) {
+		return nil, 0, fmt.Errorf("iotools: message too large; %d > %d", msgLen, pr.maxSize)
+	}
+
+	size := int(msgLen)
+	if pr.checksum {
+		size += 4
+	}
+	if cap(pr.buf) < size || pr.buf == nil {
+		pr.buf = make([]byte, size)
+	}
+	buf := pr.buf[:size]
+
+	if _, err := io.ReadFull(pr.rdr, buf); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, 0, fmt.Errorf("iotools: short message read; expected %d bytes: %w", size, io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("iotools: messagereader read failed: %w", err)
+	}
+
+	out = buf[:msgLen]
+	if pr.checksum {
+		if crc32.Checksum(out, castagnoli) != binary.LittleEndian.Uint32(buf[msgLen:]) {
+			return nil, 0, ErrMessageChecksum
+		}
+	}
+	return out, int(msgLen), nil
+}
+
+// MessageIOReader adapts a MessageReader to an io.Reader that reads one
+// message at a time, like archive/tar.Reader: call Next to advance to the next
+// message, then Read until io.EOF to read its contents.
+type MessageIOReader struct {
+	mr  MessageReader
+	cur []byte
+}
+
+func NewMessageIOReader(mr MessageReader) *MessageIOReader {
+	return &MessageIOReader{mr: mr}
+}
+
+// maxConsecutiveEmptyMessageReads is the number of times Next will call
+// ReadNext when it returns neither a message nor an error, as bufio does for
+// io.Readers that return (0, nil).
+const maxConsecutiveEmptyMessageReads = 100
+
+// Next advances to the next message, discarding any unread part of the
+// current message. It returns io.EOF if there are no more messages.
+//
+// If the MessageReader returns a nil message and a nil error, Next tries
+// again, but returns io.ErrNoProgress if that happens too many times in a
+// row.
+func (r *MessageIOReader) Next() error {
+	for i := 0; i < maxConsecutiveEmptyMessageReads; i++ {
+		out, _, err := r.mr.ReadNext()
+		if err != nil {
+			r.cur = nil
+			return err
+		}
+		if out != nil {
+			r.cur = out
+			return nil
+		}
+	}
+	return io.ErrNoProgress
+}
+
+// Read reads from the current message. It returns io.EOF at the end of the
+// message, or before the first call to Next.
+func (r *MessageIOReader) Read(p []byte) (n int, err error) {
+	if len(r.cur) == 0 {
+		return 0, io.EOF
+	}
+	n = copy(p, r.cur)
+	r.cur = r.cur[n:]
+	return n, nil
+}
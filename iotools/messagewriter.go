@@ -0,0 +1,108 @@
+package iotools
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// MessageWriter is implemented by the MessageWriter* types.
+type MessageWriter interface {
+	WriteMessage(msg []byte) error
+}
+
+var (
+	_ MessageWriter = &MessageWriterBytePrefix{}
+	_ MessageWriter = &MessageWriterShortPrefix{}
+	_ MessageWriter = &MessageWriterUvarint{}
+)
+
+// MessageWriterBytePrefix writes messages of up to 255 bytes for
+// MessageReaderBytePrefix. Empty messages are written, but are skipped by
+// MessageReaderBytePrefix.
+type MessageWriterBytePrefix struct {
+	w   io.Writer
+	buf []byte
+}
+
+func NewMessageWriterBytePrefix(w io.Writer) *MessageWriterBytePrefix {
+	return &MessageWriterBytePrefix{w: w}
+}
+
+// WriteMessage writes the length of msg followed by msg in a single call to
+// the underlying io.Writer.
+func (pw *MessageWriterBytePrefix) WriteMessage(msg []byte) error {
+	if len(msg) > 255 {
+		return fmt.Errorf("iotools: message too large; %d > %d", len(msg), 255)
+	}
+	pw.buf = append(append(pw.buf[:0], byte(len(msg))), msg...)
+	return writeMessage(pw.w, pw.buf)
+}
+
+// MessageWriterShortPrefix writes messages of up to 65535 bytes for
+// MessageReaderShortPrefix. Empty messages are written, but are skipped by
+// MessageReaderShortPrefix.
+type MessageWriterShortPrefix struct {
+	w   io.Writer
+	buf []byte
+}
+
+func NewMessageWriterShortPrefix(w io.Writer) *MessageWriterShortPrefix {
+	return &MessageWriterShortPrefix{w: w}
+}
+
+// WriteMessage writes the length of msg as a little-endian uint16 followed by
+// msg in a single call to the underlying io.Writer.
+func (pw *MessageWriterShortPrefix) WriteMessage(msg []byte) error {
+	if len(msg) > 65535 {
+		return fmt.Errorf("iotools: message too large; %d > %d", len(msg), 65535)
+	}
+	pw.buf = append(append(pw.buf[:0], byte(len(msg)), byte(len(msg)>>8)), msg...)
+	return writeMessage(pw.w, pw.buf)
+}
+
+// MessageWriterUvarint writes messages for MessageReaderUvarint. See
+// MessageReaderUvarint for the format.
+type MessageWriterUvarint struct {
+	w        io.Writer
+	buf      []byte
+	maxSize  int
+	checksum bool
+}
+
+// NewMessageWriterUvarint returns a MessageWriterUvarint that refuses to write
+// messages larger than maxSize. If maxSize is <= 0, DefaultMessageMaxSize is
+// used. If checksum is set, a CRC32C follows each message.
+func NewMessageWriterUvarint(w io.Writer, maxSize int, checksum bool) *MessageWriterUvarint {
+	if maxSize <= 0 {
+		maxSize = DefaultMessageMaxSize
+	}
+	return &MessageWriterUvarint{w: w, maxSize: maxSize, checksum: checksum}
+}
+
+// WriteMessage writes the framed msg in a single call to the underlying
+// io.Writer.
+func (pw *MessageWriterUvarint) WriteMessage(msg []byte) error {
+	if len(msg) > pw.maxSize {
+		return fmt.Errorf("iotools: message too large; %d > %d", len(msg), pw.maxSize)
+	}
+	pw.buf = appendUvarint(pw.buf[:0], uint64(len(msg)))
+	pw.buf = append(pw.buf, msg...)
+	if pw.checksum {
+		var crc [4]byte
+		binary.LittleEndian.PutUint32(crc[:], crc32.Checksum(msg, castagnoli))
+		pw.buf = append(pw.buf, crc[:]...)
+	}
+	return writeMessage(pw.w, pw.buf)
+}
+
+func writeMessage(w io.Writer, buf []byte) error {
+	n, err := w.Write(buf)
+	if err != nil {
+		return fmt.Errorf("iotools: messagewriter write failed: %w", err)
+	} else if n != len(buf) {
+		return fmt.Errorf("iotools: messagewriter short write; expected %d bytes, wrote %d: %w", len(buf), n, io.ErrShortWrite)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package iotools
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestMessageWriterRoundTrip(t *testing.T) {
+	msgs := [][]byte{[]byte("a"), bytes.Repeat([]byte("b"), 255), []byte("hello")}
+
+	for _, tc := range []struct {
+		name string
+		w    func(w io.Writer) MessageWriter
+		r    func(r io.Reader) MessageReader
+	}{
+		{"byte",
+			func(w io.Writer) MessageWriter { return NewMessageWriterBytePrefix(w) },
+			func(r io.Reader) MessageReader { return NewMessageReaderBytePrefix(r, nil) }},
+		{"short",
+			func(w io.Writer) MessageWriter { return NewMessageWriterShortPrefix(w) },
+			func(r io.Reader) MessageReader { return NewMessageReaderShortPrefix(r, nil) }},
+		{"uvarint",
+			func(w io.Writer) MessageWriter { return NewMessageWriterUvarint(w, 0, false) },
+			func(r io.Reader) MessageReader { return NewMessageReaderUvarint(r, 0, false) }},
+		{"uvarint-crc",
+			func(w io.Writer) MessageWriter { return NewMessageWriterUvarint(w, 0, true) },
+			func(r io.Reader) MessageReader { return NewMessageReaderUvarint(r, 0, true) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			mw := tc.w(&buf)
+			for _, msg := range msgs {
+				if err := mw.WriteMessage(msg); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			mr := tc.r(&buf)
+			var out [][]byte
+			for {
+				msg, n, err := mr.ReadNext()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+				if n != len(msg) {
+					t.Fatal(n, len(msg))
+				}
+				out = append(out, append([]byte(nil), msg...))
+			}
+			if !reflect.DeepEqual(msgs, out) {
+				t.Fatal(out)
+			}
+		})
+	}
+}
+
+func TestMessageWriterTooLarge(t *testing.T) {
+	if err := NewMessageWriterBytePrefix(ioutil.Discard).WriteMessage(make([]byte, 256)); err == nil {
+		t.Fatal()
+	}
+	if err := NewMessageWriterShortPrefix(ioutil.Discard).WriteMessage(make([]byte, 65536)); err == nil {
+		t.Fatal()
+	}
+	if err := NewMessageWriterUvarint(ioutil.Discard, 10, false).WriteMessage(make([]byte, 11)); err == nil {
+		t.Fatal()
+	}
+
+	var buf bytes.Buffer
+	if err := NewMessageWriterUvarint(&buf, 0, false).WriteMessage(make([]byte, 11)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := NewMessageReaderUvarint(&buf, 10, false).ReadNext(); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestMessageReaderUvarint(t *testing.T) {
+	var buf bytes.Buffer
+	mw := NewMessageWriterUvarint(&buf, 0, true)
+	for _, msg := range []string{"", "yep", ""} {
+		if err := mw.WriteMessage([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raw := buf.Bytes()
+
+	// Empty messages are returned, not skipped:
+	mr := NewMessageReaderUvarint(bytes.NewReader(raw), 0, true)
+	for i := 0; i < 3; i++ {
+		if _, _, err := mr.ReadNext(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+	if _, _, err := mr.ReadNext(); err != io.EOF {
+		t.Fatal(err)
+	}
+
+	// Checksum mismatch:
+	bad := append([]byte(nil), raw...)
+	bad[6] ^= 0x1
+	mr = NewMessageReaderUvarint(bytes.NewReader(bad), 0, true)
+	if _, _, err := mr.ReadNext(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := mr.ReadNext(); err != ErrMessageChecksum {
+		t.Fatal(err)
+	}
+
+	// Truncated:
+	mr = NewMessageReaderUvarint(bytes.NewReader(raw[:8]), 0, true)
+	mr.ReadNext()
+	if _, _, err := mr.ReadNext(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatal(err)
+	}
+}
+
+func TestMessageIOReader(t *testing.T) {
+	var buf bytes.Buffer
+	mw := NewMessageWriterUvarint(&buf, 0, false)
+	for _, msg := range []string{"hello", "", "world"} {
+		if err := mw.WriteMessage([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rdr := NewMessageIOReader(NewMessageReaderUvarint(&buf, 0, false))
+	if n, err := rdr.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		t.Fatal(n, err)
+	}
+
+	var out []string
+	for {
+		if err := rdr.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		msg, err := ioutil.ReadAll(rdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, string(msg))
+	}
+	if !reflect.DeepEqual([]string{"hello", "", "world"}, out) {
+		t.Fatal(out)
+	}
+}
+
+type emptyMessageReader struct{ reads int }
+
+func (r *emptyMessageReader) ReadNext() (out []byte, n int, err error) {
+	r.reads++
+	return nil, 0, nil
+}
+
+func TestMessageIOReaderNoProgress(t *testing.T) {
+	mr := &emptyMessageReader{}
+	rdr := NewMessageIOReader(mr)
+	if err := rdr.Next(); err != io.ErrNoProgress {
+		t.Fatal(err)
+	}
+	if mr.reads != maxConsecutiveEmptyMessageReads {
+		t.Fatal(mr.reads)
+	}
+}
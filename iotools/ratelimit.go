@@ -0,0 +1,216 @@
+package iotools
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that limits the number of bytes per second
+// passed through any RateLimitedReaders and RateLimitedWriters that share it.
+// The bucket starts full, holds at most Burst bytes, and refills at Rate
+// bytes per second.
+//
+// A RateLimiter is safe for concurrent use, and its limits can be changed
+// while it is in use with SetLimit.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+
+	// Replaced in tests:
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewRateLimiter returns a RateLimiter that allows bytesPerSec bytes per
+// second, in bursts of up to burst bytes. If bytesPerSec <= 0, the limiter
+// does not limit. If burst <= 0, one second's worth of bytes is used.
+func NewRateLimiter(bytesPerSec float64, burst int) *RateLimiter {
+	lim := &RateLimiter{now: time.Now, sleep: sleepContext}
+	lim.rate, lim.burst = bytesPerSec, rateLimitBurst(bytesPerSec, burst)
+	lim.tokens = float64(lim.burst)
+	lim.last = lim.now()
+	return lim
+}
+
+func rateLimitBurst(bytesPerSec float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	if bytesPerSec >= math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if b := int(math.Ceil(bytesPerSec)); b > 0 {
+		return b
+	}
+	return 1
+}
+
+// SetLimit changes the rate and burst size, which apply to any waits that
+// start after SetLimit returns. The arguments are interpreted in the same way
+// as NewRateLimiter's.
+func (lim *RateLimiter) SetLimit(bytesPerSec float64, burst int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.advance(lim.now())
+	lim.rate, lim.burst = bytesPerSec, rateLimitBurst(bytesPerSec, burst)
+	if lim.tokens > float64(lim.burst) {
+		lim.tokens = float64(lim.burst)
+	}
+}
+
+// Limit returns the current rate and burst size.
+func (lim *RateLimiter) Limit() (bytesPerSec float64, burst int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return lim.rate, lim.burst
+}
+
+// WaitN blocks until n bytes may pass, or until ctx is done, in which case
+// ctx.Err() is returned and the bytes are not counted. n may be larger than
+// the burst size, in which case WaitN waits until the bucket has refilled
+// enough to cover the excess.
+func (lim *RateLimiter) WaitN(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	lim.mu.Lock()
+	if lim.rate <= 0 || n <= 0 {
+		lim.mu.Unlock()
+		return nil
+	}
+	lim.advance(lim.now())
+	lim.tokens -= float64(n)
+	var wait time.Duration
+	if lim.tokens < 0 {
+		wait = time.Duration(-lim.tokens / lim.rate * float64(time.Second))
+	}
+	lim.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if err := lim.sleep(ctx, wait); err != nil {
+		lim.mu.Lock()
+		lim.tokens = math.Min(lim.tokens+float64(n), float64(lim.burst))
+		lim.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// chunk returns the largest number of bytes that should be passed through in
+// one read or write, or 0 if there is no limit.
+func (lim *RateLimiter) chunk() int {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	if lim.rate <= 0 {
+		return 0
+	}
+	return lim.burst
+}
+
+// advance must be called with lim.mu held.
+func (lim *RateLimiter) advance(now time.Time) {
+	if elapsed := now.Sub(lim.last); elapsed > 0 && lim.rate > 0 {
+		lim.tokens = math.Min(lim.tokens+elapsed.Seconds()*lim.rate, float64(lim.burst))
+	}
+	lim.last = now
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	tm := time.NewTimer(d)
+	defer tm.Stop()
+	select {
+	case <-tm.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimitedReader limits the rate at which bytes can be read from an
+// io.Reader. Each Read reads at most the limiter's burst size, then waits for
+// the bytes it read to be allowed.
+//
+// It can be wrapped by or wrap other readers, for example to hash a
+// throttled stream:
+//
+//	NewHashingReader(NewRateLimitedReader(ctx, rdr, lim), sha256.New())
+//
+type RateLimitedReader struct {
+	ctx context.Context
+	rdr io.Reader
+	lim *RateLimiter
+}
+
+// NewRateLimitedReader returns a RateLimitedReader that stops waiting and
+// returns ctx.Err() from Read when ctx is done. Several readers and writers
+// may share the same RateLimiter.
+func NewRateLimitedReader(ctx context.Context, rdr io.Reader, lim *RateLimiter) *RateLimitedReader {
+	return &RateLimitedReader{ctx: ctx, rdr: rdr, lim: lim}
+}
+
+func (r *RateLimitedReader) Read(p []byte) (n int, err error) {
+	if chunk := r.lim.chunk(); chunk > 0 && len(p) > chunk {
+		p = p[:chunk]
+	}
+	n, err = r.rdr.Read(p)
+	if n > 0 {
+		if werr := r.lim.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+func (r *RateLimitedReader) Unwrap() io.Reader { return r.rdr }
+
+// RateLimitedWriter limits the rate at which bytes can be written to an
+// io.Writer. A Write larger than the limiter's burst size is split into
+// several writes to the underlying io.Writer, so if writes from several
+// goroutines must not be interleaved, wrap it in a LockedWriter:
+//
+//	NewLockedWriter(NewRateLimitedWriter(ctx, w, lim))
+//
+type RateLimitedWriter struct {
+	ctx context.Context
+	w   io.Writer
+	lim *RateLimiter
+}
+
+// NewRateLimitedWriter returns a RateLimitedWriter that stops waiting and
+// returns ctx.Err() from Write when ctx is done. Several readers and writers
+// may share the same RateLimiter.
+func NewRateLimitedWriter(ctx context.Context, w io.Writer, lim *RateLimiter) *RateLimitedWriter {
+	return &RateLimitedWriter{ctx: ctx, w: w, lim: lim}
+}
+
+func (rw *RateLimitedWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		sz := len(p)
+		if chunk := rw.lim.chunk(); chunk > 0 && sz > chunk {
+			sz = chunk
+		}
+		if err := rw.lim.WaitN(rw.ctx, sz); err != nil {
+			return n, err
+		}
+		wn, err := rw.w.Write(p[:sz])
+		n += wn
+		if err != nil {
+			return n, err
+		} else if wn != sz {
+			return n, io.ErrShortWrite
+		}
+		p = p[sz:]
+	}
+	return n, nil
+}
+
+func (rw *RateLimitedWriter) Unwrap() io.Writer { return rw.w }
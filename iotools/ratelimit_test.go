@@ -0,0 +1,157 @@
+package iotools
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+type fakeRateClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func newTestRateLimiter(bytesPerSec float64, burst int) (*RateLimiter, *fakeRateClock) {
+	fc := &fakeRateClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	lim := NewRateLimiter(bytesPerSec, burst)
+	lim.now = func() time.Time { return fc.now }
+	lim.sleep = func(ctx context.Context, d time.Duration) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		fc.now = fc.now.Add(d)
+		fc.slept += d
+		return nil
+	}
+	lim.last = fc.now
+	return lim, fc
+}
+
+func TestRateLimitedWriter(t *testing.T) {
+	lim, fc := newTestRateLimiter(100, 100)
+
+	var buf bytes.Buffer
+	w := NewRateLimitedWriter(context.Background(), &buf, lim)
+	n, err := w.Write(make([]byte, 350))
+	if err != nil || n != 350 || buf.Len() != 350 {
+		t.Fatal(n, err)
+	}
+
+	// The first 100 bytes are covered by the initial burst:
+	if fc.slept != 2500*time.Millisecond {
+		t.Fatal(fc.slept)
+	}
+}
+
+func TestRateLimitedReaderChunks(t *testing.T) {
+	lim, fc := newTestRateLimiter(1000, 10)
+	rdr := NewRateLimitedReader(context.Background(), bytes.NewReader(make([]byte, 50)), lim)
+
+	p := make([]byte, 50)
+	if n, err := rdr.Read(p); n != 10 || err != nil {
+		t.Fatal(n, err)
+	}
+	out, err := ioutil.ReadAll(rdr)
+	if err != nil || len(out) != 40 {
+		t.Fatal(len(out), err)
+	}
+	if fc.slept != 40*time.Millisecond {
+		t.Fatal(fc.slept)
+	}
+}
+
+func TestRateLimiterShared(t *testing.T) {
+	lim, fc := newTestRateLimiter(100, 100)
+	ctx := context.Background()
+
+	w1 := NewRateLimitedWriter(ctx, ioutil.Discard, lim)
+	w2 := NewRateLimitedWriter(ctx, ioutil.Discard, lim)
+	w1.Write(make([]byte, 100))
+	if fc.slept != 0 {
+		t.Fatal(fc.slept)
+	}
+	w2.Write(make([]byte, 100))
+	if fc.slept != time.Second {
+		t.Fatal(fc.slept)
+	}
+}
+
+func TestRateLimiterSetLimit(t *testing.T) {
+	lim, fc := newTestRateLimiter(100, 100)
+	ctx := context.Background()
+	w := NewRateLimitedWriter(ctx, ioutil.Discard, lim)
+	w.Write(make([]byte, 100))
+
+	lim.SetLimit(200, 0)
+	if rate, burst := lim.Limit(); rate != 200 || burst != 200 {
+		t.Fatal(rate, burst)
+	}
+	w.Write(make([]byte, 100))
+	if fc.slept != 500*time.Millisecond {
+		t.Fatal(fc.slept)
+	}
+
+	lim.SetLimit(0, 0)
+	w.Write(make([]byte, 100000))
+	if fc.slept != 500*time.Millisecond {
+		t.Fatal(fc.slept)
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	lim, fc := newTestRateLimiter(100, 100)
+	ctx := context.Background()
+
+	lim.WaitN(ctx, 100)
+	fc.now = fc.now.Add(10 * time.Second) // Refills, but no more than burst.
+	lim.WaitN(ctx, 150)
+	if fc.slept != 500*time.Millisecond {
+		t.Fatal(fc.slept)
+	}
+}
+
+func TestRateLimiterCancelled(t *testing.T) {
+	lim, _ := newTestRateLimiter(100, 100)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := NewRateLimitedWriter(ctx, ioutil.Discard, lim)
+	if n, err := w.Write([]byte("yep")); n != 0 || err != context.Canceled {
+		t.Fatal(n, err)
+	}
+
+	// Real sleeps are interrupted:
+	lim = NewRateLimiter(1, 1)
+	lim.WaitN(context.Background(), 1)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := lim.WaitN(ctx, 1); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+}
+
+func TestRateLimitedReaderHashing(t *testing.T) {
+	lim, _ := newTestRateLimiter(100, 16)
+	in := bytes.Repeat([]byte("abcdefgh"), 100)
+
+	hr := NewHashingReader(NewRateLimitedReader(context.Background(), bytes.NewReader(in), lim), sha256.New())
+	out, err := ioutil.ReadAll(hr)
+	if err != nil || !bytes.Equal(in, out) {
+		t.Fatal(err)
+	}
+	if expected := sha256.Sum256(in); !bytes.Equal(expected[:], hr.Hash.Sum(nil)) {
+		t.Fatal()
+	}
+}
+
+func TestRateLimitedWriterLocked(t *testing.T) {
+	lim := NewRateLimiter(0, 0)
+	var buf bytes.Buffer
+	w := NewLockedWriter(NewRateLimitedWriter(context.Background(), &buf, lim))
+	if _, err := w.Write([]byte("yep")); err != nil || buf.String() != "yep" {
+		t.Fatal(buf.String(), err)
+	}
+}
@@ -0,0 +1,287 @@
+package times
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// HolidayRule calculates the date on which a holiday falls in a given year.
+type HolidayRule interface {
+	// Date returns the date of the holiday in year, or false if the holiday
+	// does not occur in that year.
+	Date(year int) (Date, bool)
+}
+
+// FixedDate is a HolidayRule for holidays that occur on the same day every
+// year, i.e. 25 December. If the day does not exist in a year (29 February),
+// the holiday does not occur.
+type FixedDate struct {
+	Month time.Month
+	Day   int
+}
+
+func (f FixedDate) Date(year int) (Date, bool) {
+	d := Date{Year: year, Month: f.Month, Day: f.Day}
+	return d, d.IsValid()
+}
+
+// NthWeekday is a HolidayRule for holidays that occur on the Nth Weekday of a
+// Month, i.e. the first Monday in May. If N is negative, it counts backwards
+// from the end of the month, so -1 is the last Weekday of the Month. If there
+// is no Nth Weekday in the Month, or if N is zero, the holiday does not occur.
+type NthWeekday struct {
+	N       int
+	Weekday time.Weekday
+	Month   time.Month
+}
+
+func (n NthWeekday) Date(year int) (Date, bool) {
+	var d Date
+	switch {
+	case n.N > 0:
+		first := Date{Year: year, Month: n.Month, Day: 1}
+		offset := (int(n.Weekday) - int(first.Weekday()) + 7) % 7
+		d = first.AddDays(offset + (n.N-1)*7)
+	case n.N < 0:
+		last := Date{Year: year, Month: n.Month, Day: DaysInMonth(year, n.Month)}
+		offset := (int(last.Weekday()) - int(n.Weekday) + 7) % 7
+		d = last.AddDays(-offset + (n.N+1)*7)
+	default:
+		return Date{}, false
+	}
+	return d, d.Year == year && d.Month == n.Month
+}
+
+// EasterOffset is a HolidayRule for holidays that occur a fixed number of
+// days before or after Easter Sunday (as per the Gregorian calendar). Good
+// Friday is -2, Easter Monday is 1.
+type EasterOffset struct {
+	Days int
+}
+
+func (e EasterOffset) Date(year int) (Date, bool) {
+	return Easter(year).AddDays(e.Days), true
+}
+
+// Easter returns the date of Easter Sunday in the Gregorian calendar, using
+// the "Anonymous Gregorian algorithm" (Meeus/Jones/Butcher).
+func Easter(year int) Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return Date{Year: year, Month: time.Month(month), Day: day}
+}
+
+// Holiday is a named HolidayRule for use with a Calendar.
+type Holiday struct {
+	Name string
+	Rule HolidayRule
+
+	// If Substitute is true and the holiday falls on a weekend or on the same
+	// day as another holiday, it is observed on the next day that is neither
+	// a weekend nor a holiday.
+	Substitute bool
+}
+
+// ObservedHoliday is a Holiday as it is observed by a Calendar in a given
+// year.
+type ObservedHoliday struct {
+	Date Date
+	Name string
+
+	// Substitute is true if Date is a substitute day for the Holiday.
+	Substitute bool
+}
+
+// Calendar determines which Dates are business days, using a set of weekend
+// days and Holidays.
+//
+// A Calendar is safe for concurrent use, but Holidays and weekend days must
+// not be changed after it has been shared between goroutines.
+type Calendar struct {
+	weekend  [7]bool
+	holidays []Holiday
+
+	mu    sync.Mutex
+	cache map[int]*observedYear
+}
+
+type observedYear struct {
+	holidays []ObservedHoliday
+	dates    map[Date]bool
+}
+
+// NewCalendar creates a Calendar with Saturday and Sunday as weekend days and
+// the Holidays passed in.
+func NewCalendar(holidays ...Holiday) *Calendar {
+	c := &Calendar{holidays: holidays}
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	return c
+}
+
+// SetWeekend replaces the Calendar's weekend days. It panics if every day of
+// the week is a weekend day, as there would be no business days.
+func (c *Calendar) SetWeekend(days ...time.Weekday) {
+	var weekend [7]bool
+	var n int
+	for _, day := range days {
+		if !weekend[day] {
+			weekend[day] = true
+			n++
+		}
+	}
+	if n == len(weekend) {
+		panic("times: calendar must have at least one business day in the week")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.weekend = weekend
+	c.cache = nil
+}
+
+// AddHoliday adds Holidays to the Calendar.
+func (c *Calendar) AddHoliday(holidays ...Holiday) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.holidays = append(c.holidays, holidays...)
+	c.cache = nil
+}
+
+func (c *Calendar) IsWeekend(d Date) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.weekend[d.Weekday()]
+}
+
+// IsHoliday reports whether d is a Holiday or a substitute day for a Holiday.
+func (c *Calendar) IsHoliday(d Date) bool {
+	return c.observed(d.Year).dates[d]
+}
+
+func (c *Calendar) IsBusinessDay(d Date) bool {
+	return !c.IsWeekend(d) && !c.IsHoliday(d)
+}
+
+// Holidays returns the Holidays observed in year, sorted by Date. Holidays
+// that occur on a weekend but which have no substitute are still included.
+func (c *Calendar) Holidays(year int) []ObservedHoliday {
+	return append([]ObservedHoliday(nil), c.observed(year).holidays...)
+}
+
+// AddBusinessDays returns the Date that is n business days after d. If n is
+// negative, the Date is n business days before d. d itself is never counted,
+// so if n is zero, d is returned even if it is not a business day.
+func (c *Calendar) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days in the half-open
+// range [from, to). If to is before from, the result is the negated number of
+// business days in [to, from).
+func (c *Calendar) BusinessDaysBetween(from, to Date) (n int) {
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	for d := from; d.Before(to); d = d.AddDays(1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n * sign
+}
+
+func (c *Calendar) observed(year int) *observedYear {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if observed, ok := c.cache[year]; ok {
+		return observed
+	}
+	if c.cache == nil {
+		c.cache = make(map[int]*observedYear)
+	}
+
+	// Substitute days can spill over into the next year, so the previous
+	// year is calculated too:
+	observed := &observedYear{dates: make(map[Date]bool)}
+	for _, h := range c.calculate(year-1, year) {
+		if h.Date.Year == year {
+			observed.holidays = append(observed.holidays, h)
+			observed.dates[h.Date] = true
+		}
+	}
+	sort.SliceStable(observed.holidays, func(i, j int) bool {
+		return observed.holidays[i].Date.Before(observed.holidays[j].Date)
+	})
+	c.cache[year] = observed
+	return observed
+}
+
+// calculate returns the Holidays and substitute days for the years from
+// fromYear to toYear inclusive. The years are calculated together so that a
+// substitute day carried over from one year can not land on the same day as
+// a Holiday or substitute day in the next.
+func (c *Calendar) calculate(fromYear, toYear int) []ObservedHoliday {
+	type actual struct {
+		Holiday
+		date Date
+	}
+
+	var actuals []actual
+	taken := make(map[Date]bool)
+	for year := fromYear; year <= toYear; year++ {
+		for _, h := range c.holidays {
+			if d, ok := h.Rule.Date(year); ok {
+				actuals = append(actuals, actual{h, d})
+			}
+		}
+	}
+	sort.SliceStable(actuals, func(i, j int) bool { return actuals[i].date.Before(actuals[j].date) })
+
+	out := make([]ObservedHoliday, 0, len(actuals))
+	for _, a := range actuals {
+		out = append(out, ObservedHoliday{Date: a.date, Name: a.Name})
+		taken[a.date] = true
+	}
+
+	// Holidays with a substitute are moved to the next available day in date
+	// order, so if Christmas and Boxing Day fall on a weekend, they are
+	// observed on the Monday and Tuesday respectively.
+	seen := make(map[Date]bool)
+	for _, a := range actuals {
+		clash := seen[a.date]
+		seen[a.date] = true
+		if !a.Substitute || (!clash && !c.weekend[a.date.Weekday()]) {
+			continue
+		}
+		d := a.date.AddDays(1)
+		for c.weekend[d.Weekday()] || taken[d] {
+			d = d.AddDays(1)
+		}
+		taken[d] = true
+		out = append(out, ObservedHoliday{Date: d, Name: a.Name, Substitute: true})
+	}
+	return out
+}
@@ -0,0 +1,203 @@
+package times
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	for _, ex := range []Date{
+		{1818, 3, 22}, {2000, 4, 23}, {2019, 4, 21}, {2020, 4, 12},
+		{2021, 4, 4}, {2024, 3, 31}, {2025, 4, 20}, {2038, 4, 25},
+	} {
+		if result := Easter(ex.Year); result != ex {
+			t.Fatal(result, "!=", ex)
+		}
+	}
+}
+
+func TestHolidayRules(t *testing.T) {
+	for idx, tc := range []struct {
+		rule HolidayRule
+		year int
+		ok   bool
+		ex   Date
+	}{
+		{FixedDate{time.December, 25}, 2021, true, Date{2021, 12, 25}},
+		{FixedDate{time.February, 29}, 2020, true, Date{2020, 2, 29}},
+		{FixedDate{time.February, 29}, 2021, false, Date{}},
+		{NthWeekday{1, time.Monday, time.May}, 2021, true, Date{2021, 5, 3}},
+		{NthWeekday{-1, time.Monday, time.May}, 2021, true, Date{2021, 5, 31}},
+		{NthWeekday{5, time.Monday, time.May}, 2021, true, Date{2021, 5, 31}},
+		{NthWeekday{5, time.Monday, time.June}, 2021, false, Date{}},
+		{NthWeekday{-5, time.Monday, time.June}, 2021, false, Date{}},
+		{NthWeekday{4, time.Thursday, time.November}, 2021, true, Date{2021, 11, 25}},
+		{NthWeekday{-2, time.Sunday, time.March}, 2021, true, Date{2021, 3, 21}},
+		{NthWeekday{0, time.Sunday, time.March}, 2021, false, Date{}},
+		{EasterOffset{-2}, 2021, true, Date{2021, 4, 2}},
+		{EasterOffset{1}, 2021, true, Date{2021, 4, 5}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			d, ok := tc.rule.Date(tc.year)
+			if ok != tc.ok {
+				t.Fatal(ok, "!=", tc.ok)
+			}
+			if ok && d != tc.ex {
+				t.Fatal(d, "!=", tc.ex)
+			}
+		})
+	}
+}
+
+func testUKCalendar() *Calendar {
+	return NewCalendar(
+		Holiday{Name: "New Year's Day", Rule: FixedDate{time.January, 1}, Substitute: true},
+		Holiday{Name: "Good Friday", Rule: EasterOffset{-2}},
+		Holiday{Name: "Easter Monday", Rule: EasterOffset{1}},
+		Holiday{Name: "Early May", Rule: NthWeekday{1, time.Monday, time.May}},
+		Holiday{Name: "Spring", Rule: NthWeekday{-1, time.Monday, time.May}},
+		Holiday{Name: "Summer", Rule: NthWeekday{-1, time.Monday, time.August}},
+		Holiday{Name: "Christmas Day", Rule: FixedDate{time.December, 25}, Substitute: true},
+		Holiday{Name: "Boxing Day", Rule: FixedDate{time.December, 26}, Substitute: true},
+	)
+}
+
+func TestCalendarSubstitute(t *testing.T) {
+	cal := testUKCalendar()
+
+	var dates []Date
+	for _, h := range cal.Holidays(2021) {
+		dates = append(dates, h.Date)
+	}
+	ex := []Date{
+		{2021, 1, 1}, {2021, 4, 2}, {2021, 4, 5}, {2021, 5, 3}, {2021, 5, 31}, {2021, 8, 30},
+		{2021, 12, 25}, {2021, 12, 26}, {2021, 12, 27}, {2021, 12, 28},
+	}
+	if !reflect.DeepEqual(dates, ex) {
+		t.Fatal(dates, "!=", ex)
+	}
+
+	// Christmas on Sunday, Boxing Day on Monday; Christmas moves to Tuesday:
+	hols := cal.Holidays(2022)
+	last := hols[len(hols)-1]
+	if last != (ObservedHoliday{Date{2022, 12, 27}, "Christmas Day", true}) {
+		t.Fatal(last)
+	}
+	if first := hols[0]; first.Date != (Date{2022, 1, 1}) || hols[1].Date != (Date{2022, 1, 3}) || !hols[1].Substitute {
+		t.Fatal(hols[:2])
+	}
+}
+
+func TestCalendarSubstituteSpillsIntoNextYear(t *testing.T) {
+	cal := NewCalendar(Holiday{Name: "NYE", Rule: FixedDate{time.December, 31}, Substitute: true})
+	if !cal.IsHoliday(Date{2023, 1, 2}) {
+		t.Fatal()
+	}
+	hols := cal.Holidays(2023)
+	if len(hols) != 2 || hols[0].Date != (Date{2023, 1, 2}) || hols[1].Date != (Date{2023, 12, 31}) {
+		t.Fatal(hols)
+	}
+}
+
+func TestCalendarSubstituteCarriedOverDoesNotClash(t *testing.T) {
+	cal := NewCalendar(
+		Holiday{Name: "NYE", Rule: FixedDate{time.December, 31}, Substitute: true},
+		Holiday{Name: "NYD", Rule: FixedDate{time.January, 1}, Substitute: true},
+	)
+	expected := []ObservedHoliday{
+		{Date: Date{2023, 1, 1}, Name: "NYD"},
+		{Date: Date{2023, 1, 2}, Name: "NYE", Substitute: true},
+		{Date: Date{2023, 1, 3}, Name: "NYD", Substitute: true},
+	}
+	if hols := cal.Holidays(2023); !reflect.DeepEqual(hols[:3], expected) {
+		t.Fatal(hols)
+	}
+}
+
+func TestCalendarBusinessDays(t *testing.T) {
+	cal := testUKCalendar()
+
+	for d, ex := range map[Date]bool{
+		{2021, 12, 24}: true,
+		{2021, 12, 25}: false,
+		{2021, 12, 27}: false,
+		{2021, 12, 29}: true,
+		{2021, 4, 2}:   false,
+	} {
+		if cal.IsBusinessDay(d) != ex {
+			t.Fatal(d, "!=", ex)
+		}
+	}
+
+	for idx, tc := range []struct {
+		in  Date
+		n   int
+		out Date
+	}{
+		{Date{2021, 12, 24}, 0, Date{2021, 12, 24}},
+		{Date{2021, 12, 25}, 0, Date{2021, 12, 25}},
+		{Date{2021, 12, 24}, 1, Date{2021, 12, 29}},
+		{Date{2021, 12, 24}, 3, Date{2021, 12, 31}},
+		{Date{2021, 12, 24}, 4, Date{2022, 1, 4}},
+		{Date{2021, 12, 29}, -1, Date{2021, 12, 24}},
+		{Date{2021, 12, 29}, -2, Date{2021, 12, 23}},
+	} {
+		t.Run(fmt.Sprintf("add/%d", idx), func(t *testing.T) {
+			if result := cal.AddBusinessDays(tc.in, tc.n); result != tc.out {
+				t.Fatal(result, "!=", tc.out)
+			}
+		})
+	}
+
+	for idx, tc := range []struct {
+		from, to Date
+		ex       int
+	}{
+		{Date{2021, 12, 24}, Date{2021, 12, 24}, 0},
+		{Date{2021, 12, 24}, Date{2021, 12, 29}, 1},
+		{Date{2021, 12, 24}, Date{2022, 1, 5}, 5},
+		{Date{2022, 1, 5}, Date{2021, 12, 24}, -5},
+		{Date{2021, 1, 4}, Date{2021, 1, 11}, 5},
+	} {
+		t.Run(fmt.Sprintf("between/%d", idx), func(t *testing.T) {
+			if result := cal.BusinessDaysBetween(tc.from, tc.to); result != tc.ex {
+				t.Fatal(result, "!=", tc.ex)
+			}
+		})
+	}
+}
+
+func TestCalendarWeekend(t *testing.T) {
+	cal := NewCalendar()
+	cal.SetWeekend(time.Friday, time.Saturday)
+	if cal.IsBusinessDay(Date{2021, 1, 1}) || !cal.IsBusinessDay(Date{2021, 1, 3}) {
+		t.Fatal()
+	}
+	if result := cal.AddBusinessDays(Date{2021, 1, 7}, 1); result != (Date{2021, 1, 10}) {
+		t.Fatal(result)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+}
+
+func TestCalendarSetWeekendConcurrent(t *testing.T) {
+	cal := NewCalendar()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			cal.SetWeekend(time.Friday, time.Saturday)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		cal.IsWeekend(Date{2021, 1, 1})
+	}
+	<-done
+}
@@ -0,0 +1,261 @@
+package times
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers. Code that needs "now" should
+// accept a Clock so tests can substitute a FakeClock. Use SystemClock for the
+// real time.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	After(d time.Duration) <-chan time.Time
+	NewTimer(d time.Duration) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is the Clock equivalent of a time.Timer. The channel is returned by a
+// method rather than a field so that it can be implemented by a FakeClock.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker is the Clock equivalent of a time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// SystemClock is a Clock that uses the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (systemClock) NewTimer(d time.Duration) Timer         { return systemTimer{time.NewTimer(d)} }
+func (systemClock) NewTicker(d time.Duration) Ticker       { return systemTicker{time.NewTicker(d)} }
+
+type systemTimer struct{ *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time { return t.Timer.C }
+
+type systemTicker struct{ *time.Ticker }
+
+func (t systemTicker) C() <-chan time.Time { return t.Ticker.C }
+
+// FakeClock is a Clock that only moves when Advance or Set is called, for use
+// in tests. Timers and tickers fire in order of their deadline (then in order
+// of creation) as the clock passes them, and the value sent on each channel is
+// that timer's deadline.
+//
+// All timers up to the new time are fired before Advance or Set returns, and
+// the clock is locked while they fire, so a receiver that calls Now() will see
+// the new time rather than the deadline.
+//
+// As with time.Timer, each timer's channel has a buffer of one; ticks are
+// dropped if the receiver is too slow to read them.
+type FakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers fakeTimerHeap
+	seq    int64
+}
+
+var _ Clock = &FakeClock{}
+
+// NewFakeClock returns a FakeClock that starts at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	fc := &FakeClock{now: now}
+	fc.cond = sync.NewCond(&fc.mu)
+	return fc
+}
+
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+func (fc *FakeClock) Since(t time.Time) time.Duration {
+	return fc.Now().Sub(t)
+}
+
+func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
+	return fc.NewTimer(d).C()
+}
+
+func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	ft := &fakeTimer{clock: fc, c: make(chan time.Time, 1), index: -1}
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.schedule(ft, d)
+	return ft
+}
+
+// NewTicker returns a Ticker that fires every d. It panics if d <= 0, like
+// time.NewTicker.
+func (fc *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("times: non-positive interval for NewTicker")
+	}
+	ft := &fakeTimer{clock: fc, c: make(chan time.Time, 1), period: d, index: -1}
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.schedule(ft, d)
+	return fakeTicker{ft}
+}
+
+// Advance moves the clock forward by d, firing any timers whose deadlines are
+// passed along the way. Negative durations are ignored.
+func (fc *FakeClock) Advance(d time.Duration) {
+	if d < 0 {
+		return
+	}
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.advanceTo(fc.now.Add(d))
+}
+
+// Set moves the clock to t, firing any timers whose deadlines are passed along
+// the way. If t is before the current time, the clock is moved back but no
+// timers fire.
+func (fc *FakeClock) Set(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if t.Before(fc.now) {
+		fc.now = t
+		return
+	}
+	fc.advanceTo(t)
+}
+
+// Pending returns the number of timers and tickers that have not yet fired or
+// been stopped.
+func (fc *FakeClock) Pending() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return len(fc.timers)
+}
+
+// BlockUntil waits until at least n timers and tickers are pending. This lets
+// a test wait for another goroutine to start waiting on the clock before
+// calling Advance.
+func (fc *FakeClock) BlockUntil(n int) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	for len(fc.timers) < n {
+		fc.cond.Wait()
+	}
+}
+
+func (fc *FakeClock) advanceTo(t time.Time) {
+	for len(fc.timers) > 0 && !fc.timers[0].when.After(t) {
+		ft := fc.timers[0]
+		fc.now = ft.when
+		if ft.period > 0 {
+			ft.when = ft.when.Add(ft.period)
+			heap.Fix(&fc.timers, 0)
+		} else {
+			heap.Pop(&fc.timers)
+		}
+		ft.fire(fc.now)
+	}
+	fc.now = t
+}
+
+// schedule must be called with fc.mu held.
+func (fc *FakeClock) schedule(ft *fakeTimer, d time.Duration) {
+	ft.when = fc.now.Add(d)
+	if d <= 0 {
+		ft.fire(fc.now)
+		return
+	}
+	fc.seq++
+	ft.seq = fc.seq
+	heap.Push(&fc.timers, ft)
+	fc.cond.Broadcast()
+}
+
+// unschedule must be called with fc.mu held.
+func (fc *FakeClock) unschedule(ft *fakeTimer) (active bool) {
+	if ft.index < 0 {
+		return false
+	}
+	heap.Remove(&fc.timers, ft.index)
+	return true
+}
+
+type fakeTimer struct {
+	clock  *FakeClock
+	c      chan time.Time
+	when   time.Time
+	period time.Duration
+	seq    int64
+	index  int
+}
+
+func (ft *fakeTimer) C() <-chan time.Time { return ft.c }
+
+func (ft *fakeTimer) fire(t time.Time) {
+	select {
+	case ft.c <- t:
+	default:
+	}
+}
+
+func (ft *fakeTimer) Stop() bool {
+	ft.clock.mu.Lock()
+	defer ft.clock.mu.Unlock()
+	return ft.clock.unschedule(ft)
+}
+
+func (ft *fakeTimer) Reset(d time.Duration) bool {
+	ft.clock.mu.Lock()
+	defer ft.clock.mu.Unlock()
+	active := ft.clock.unschedule(ft)
+	ft.clock.schedule(ft, d)
+	return active
+}
+
+type fakeTicker struct{ *fakeTimer }
+
+func (t fakeTicker) Stop() { t.fakeTimer.Stop() }
+
+type fakeTimerHeap []*fakeTimer
+
+func (h fakeTimerHeap) Len() int { return len(h) }
+
+func (h fakeTimerHeap) Less(i, j int) bool {
+	if !h[i].when.Equal(h[j].when) {
+		return h[i].when.Before(h[j].when)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h fakeTimerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *fakeTimerHeap) Push(x interface{}) {
+	ft := x.(*fakeTimer)
+	ft.index = len(*h)
+	*h = append(*h, ft)
+}
+
+func (h *fakeTimerHeap) Pop() interface{} {
+	old := *h
+	ft := old[len(old)-1]
+	old[len(old)-1] = nil
+	ft.index = -1
+	*h = old[:len(old)-1]
+	return ft
+}
@@ -0,0 +1,104 @@
+package times
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClockTimersFireInOrder(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	t2 := clock.NewTimer(2 * time.Second)
+	t1 := clock.NewTimer(1 * time.Second)
+	t3 := clock.NewTimer(3 * time.Second)
+	stopped := clock.NewTimer(2 * time.Second)
+	if !stopped.Stop() || stopped.Stop() {
+		t.Fatal()
+	}
+	if clock.Pending() != 3 {
+		t.Fatal(clock.Pending())
+	}
+
+	clock.Advance(2500 * time.Millisecond)
+	if tm := <-t1.C(); !tm.Equal(start.Add(time.Second)) {
+		t.Fatal(tm)
+	}
+	if tm := <-t2.C(); !tm.Equal(start.Add(2 * time.Second)) {
+		t.Fatal(tm)
+	}
+	select {
+	case <-t3.C():
+		t.Fatal()
+	case <-stopped.C():
+		t.Fatal()
+	default:
+	}
+	if clock.Since(start) != 2500*time.Millisecond {
+		t.Fatal(clock.Since(start))
+	}
+
+	if !t3.Reset(time.Second) {
+		t.Fatal()
+	}
+	clock.Advance(time.Second)
+	if tm := <-t3.C(); !tm.Equal(start.Add(3500 * time.Millisecond)) {
+		t.Fatal(tm)
+	}
+
+	select {
+	case <-clock.After(0):
+	default:
+		t.Fatal("After(0) did not fire immediately")
+	}
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	ticker := clock.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for i := 1; i <= 3; i++ {
+		clock.Advance(time.Minute)
+		if tm := <-ticker.C(); !tm.Equal(start.Add(time.Duration(i) * time.Minute)) {
+			t.Fatal(i, tm)
+		}
+	}
+
+	// Ticks are dropped if the receiver is slow:
+	clock.Advance(3 * time.Minute)
+	if tm := <-ticker.C(); !tm.Equal(start.Add(4 * time.Minute)) {
+		t.Fatal(tm)
+	}
+	select {
+	case tm := <-ticker.C():
+		t.Fatal(tm)
+	default:
+	}
+}
+
+func TestFakeClockBlockUntil(t *testing.T) {
+	clock := NewFakeClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	done := make(chan time.Time)
+	go func() {
+		done <- <-clock.After(time.Hour)
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Hour)
+	if tm := <-done; !tm.Equal(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Fatal(tm)
+	}
+}
+
+func TestDateTodayFrom(t *testing.T) {
+	clock := NewFakeClock(time.Date(2020, 2, 29, 23, 59, 0, 0, time.UTC))
+	if d := (Date{}).TodayFrom(clock); d != (Date{2020, 2, 29}) {
+		t.Fatal(d)
+	}
+	clock.Advance(time.Minute)
+	if d := (Date{}).TodayFrom(clock); d != (Date{2020, 3, 1}) {
+		t.Fatal(d)
+	}
+}
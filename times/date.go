@@ -83,7 +83,16 @@ func ParseDate(s string) (date Date, err error) {
 }
 
 func (d Date) Today() Date {
-	now := time.Now()
+	return d.TodayFrom(SystemClock)
+}
+
+// TodayFrom returns the current date according to clock, in the location of
+// the time returned by clock.Now(). If clock is nil, SystemClock is used.
+func (d Date) TodayFrom(clock Clock) Date {
+	if clock == nil {
+		clock = SystemClock
+	}
+	now := clock.Now()
 	return Date{Year: now.Year(), Month: now.Month(), Day: now.Day()}
 }
 
@@ -93,6 +102,63 @@ func (d Date) DaysSince(s Date) (days int) {
 	return int(deltaUnix / 86400)
 }
 
+// AddDays returns the Date n days after d. n may be negative.
+func (d Date) AddDays(n int) Date {
+	return DateFromTime(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// AddMonths returns the Date n months after d. n may be negative.
+//
+// If the day of the month does not exist in the resulting month, it is
+// clamped to the last day of that month, so 2020-01-31 plus one month is
+// 2020-02-29. Note that this differs from times.AddMonths, which normalises
+// the overflow the same way time.Date does (2020-03-02).
+func (d Date) AddMonths(n int) Date {
+	months := d.Year*12 + int(d.Month) - 1 + n
+	y, m := months/12, months%12
+	if m < 0 {
+		y, m = y-1, m+12
+	}
+	out := Date{Year: y, Month: time.Month(m + 1), Day: d.Day}
+	if last := DaysInMonth(out.Year, out.Month); out.Day > last {
+		out.Day = last
+	}
+	return out
+}
+
+// AddYears returns the Date n years after d. n may be negative. 29 February
+// is clamped to 28 February in years that are not leap years.
+func (d Date) AddYears(n int) Date {
+	return d.AddMonths(n * 12)
+}
+
+// Sub returns the difference between d and u as a TimeDiff, which is negative
+// if d is before u. Only the Years, Months and Days fields of the result are
+// used.
+func (d Date) Sub(u Date) TimeDiff {
+	return Diff(u.In(time.UTC), d.In(time.UTC))
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+func (d Date) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+// StartOfMonth returns the first day of the month that contains d.
+func (d Date) StartOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: 1}
+}
+
+// EndOfMonth returns the last day of the month that contains d. Note that
+// this is inclusive; use StartOfMonth().AddMonths(1) for an exclusive end.
+func (d Date) EndOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: DaysInMonth(d.Year, d.Month)}
+}
+
 func (d Date) IsZero() bool {
 	return d == Date{}
 }
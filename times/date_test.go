@@ -3,6 +3,7 @@ package times
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -72,3 +73,98 @@ func TestUnmarshalZeroValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDateAddDays(t *testing.T) {
+	for idx, tc := range []struct {
+		in  Date
+		n   int
+		out Date
+	}{
+		{Date{2020, 1, 1}, 0, Date{2020, 1, 1}},
+		{Date{2020, 1, 31}, 1, Date{2020, 2, 1}},
+		{Date{2020, 2, 28}, 1, Date{2020, 2, 29}},
+		{Date{2021, 2, 28}, 1, Date{2021, 3, 1}},
+		{Date{2020, 1, 1}, -1, Date{2019, 12, 31}},
+		{Date{2020, 1, 1}, 366, Date{2021, 1, 1}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.in.AddDays(tc.n); result != tc.out {
+				t.Fatal(result, "!=", tc.out)
+			}
+		})
+	}
+}
+
+func TestDateAddMonths(t *testing.T) {
+	for idx, tc := range []struct {
+		in  Date
+		n   int
+		out Date
+	}{
+		{Date{2020, 1, 15}, 1, Date{2020, 2, 15}},
+		{Date{2020, 1, 31}, 1, Date{2020, 2, 29}},
+		{Date{2021, 1, 31}, 1, Date{2021, 2, 28}},
+		{Date{2020, 3, 31}, -1, Date{2020, 2, 29}},
+		{Date{2020, 5, 31}, 1, Date{2020, 6, 30}},
+		{Date{2020, 11, 30}, 2, Date{2021, 1, 30}},
+		{Date{2020, 1, 1}, -1, Date{2019, 12, 1}},
+		{Date{2020, 1, 1}, -13, Date{2018, 12, 1}},
+		{Date{2020, 1, 1}, -24, Date{2018, 1, 1}},
+		{Date{2020, 1, 1}, 24, Date{2022, 1, 1}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.in.AddMonths(tc.n); result != tc.out {
+				t.Fatal(result, "!=", tc.out)
+			}
+		})
+	}
+
+	if result := (Date{2020, 2, 29}).AddYears(1); result != (Date{2021, 2, 28}) {
+		t.Fatal(result)
+	}
+	if result := (Date{2020, 2, 29}).AddYears(-4); result != (Date{2016, 2, 29}) {
+		t.Fatal(result)
+	}
+}
+
+func TestDateCalendar(t *testing.T) {
+	d := Date{2021, 1, 3}
+	if d.Weekday() != time.Sunday {
+		t.Fatal(d.Weekday())
+	}
+	if y, w := d.ISOWeek(); y != 2020 || w != 53 {
+		t.Fatal(y, w)
+	}
+	if y, w := (Date{2021, 1, 4}).ISOWeek(); y != 2021 || w != 1 {
+		t.Fatal(y, w)
+	}
+
+	if s := (Date{2020, 2, 15}).StartOfMonth(); s != (Date{2020, 2, 1}) {
+		t.Fatal(s)
+	}
+	if e := (Date{2020, 2, 15}).EndOfMonth(); e != (Date{2020, 2, 29}) {
+		t.Fatal(e)
+	}
+	if e := (Date{2021, 12, 1}).EndOfMonth(); e != (Date{2021, 12, 31}) {
+		t.Fatal(e)
+	}
+}
+
+func TestDateSub(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b Date
+		ex   TimeDiff
+	}{
+		{Date{2020, 1, 1}, Date{2020, 1, 1}, TimeDiff{}},
+		{Date{2020, 3, 15}, Date{2020, 1, 1}, TimeDiff{Months: 2, Days: 14}},
+		{Date{2020, 1, 1}, Date{2020, 3, 15}, TimeDiff{Months: 2, Days: 14, Negative: true}},
+		{Date{2021, 2, 28}, Date{2020, 2, 29}, TimeDiff{Months: 11, Days: 30}},
+		{Date{2024, 2, 29}, Date{2020, 2, 29}, TimeDiff{Years: 4}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.a.Sub(tc.b); result != tc.ex {
+				t.Fatalf("%+v != %+v", result, tc.ex)
+			}
+		})
+	}
+}
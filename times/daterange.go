@@ -0,0 +1,419 @@
+package times
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DateRange is a half-open range of Dates, [Start, End). End is not included
+// in the range, so a DateRange for the whole of January 2020 is
+// {2020-01-01, 2020-02-01}.
+//
+// The text form uses the inclusive last day instead of End, because that is
+// how people write date ranges: January 2020 is "2020-01-01..2020-01-31".
+type DateRange struct {
+	Start, End Date
+}
+
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// DateRangeOfMonth returns the DateRange covering the month that contains d.
+func DateRangeOfMonth(d Date) DateRange {
+	start := d.StartOfMonth()
+	return DateRange{Start: start, End: start.AddMonths(1)}
+}
+
+// Len returns the number of days in the range.
+func (r DateRange) Len() int {
+	if !r.Start.Before(r.End) {
+		return 0
+	}
+	return r.End.DaysSince(r.Start)
+}
+
+func (r DateRange) IsEmpty() bool { return !r.Start.Before(r.End) }
+
+// Contains reports whether d is within the half-open range [Start, End).
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && d.Before(r.End)
+}
+
+// ContainsRange reports whether every Date in o is also in r. An empty range
+// is contained by every range.
+func (r DateRange) ContainsRange(o DateRange) bool {
+	if o.IsEmpty() {
+		return true
+	}
+	return !o.Start.Before(r.Start) && !o.End.After(r.End)
+}
+
+// Overlaps reports whether r and o have at least one Date in common.
+func (r DateRange) Overlaps(o DateRange) bool {
+	return !r.IsEmpty() && !o.IsEmpty() && r.Start.Before(o.End) && o.Start.Before(r.End)
+}
+
+// Intersect returns the Dates that are in both r and o. If the ranges do not
+// overlap, ok is false.
+func (r DateRange) Intersect(o DateRange) (out DateRange, ok bool) {
+	if !r.Overlaps(o) {
+		return DateRange{}, false
+	}
+	return DateRange{Start: maxDate(r.Start, o.Start), End: minDate(r.End, o.End)}, true
+}
+
+// Merge returns the Dates that are in either r or o. If the ranges neither
+// overlap nor touch, the result would include Dates that are in neither
+// range, so ok is false. Merging with an empty range returns the other range.
+func (r DateRange) Merge(o DateRange) (out DateRange, ok bool) {
+	if r.IsEmpty() {
+		return o, true
+	} else if o.IsEmpty() {
+		return r, true
+	}
+	if r.Start.After(o.End) || o.Start.After(r.End) {
+		return DateRange{}, false
+	}
+	return DateRange{Start: minDate(r.Start, o.Start), End: maxDate(r.End, o.End)}, true
+}
+
+// Subtract returns the parts of r that are not in o, in order. The result
+// contains between zero and two ranges.
+func (r DateRange) Subtract(o DateRange) []DateRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Overlaps(o) {
+		return []DateRange{r}
+	}
+	var out []DateRange
+	if r.Start.Before(o.Start) {
+		out = append(out, DateRange{Start: r.Start, End: o.Start})
+	}
+	if o.End.Before(r.End) {
+		out = append(out, DateRange{Start: o.End, End: r.End})
+	}
+	return out
+}
+
+// Each calls fn for each Date in the range, in order, until fn returns false.
+func (r DateRange) Each(fn func(d Date) bool) {
+	for d := r.Start; d.Before(r.End); d = d.AddDays(1) {
+		if !fn(d) {
+			return
+		}
+	}
+}
+
+// Dates returns a slice containing every Date in the range.
+func (r DateRange) Dates() []Date {
+	out := make([]Date, 0, r.Len())
+	r.Each(func(d Date) bool {
+		out = append(out, d)
+		return true
+	})
+	return out
+}
+
+// Iter returns an iterator over the Dates in the range:
+//
+//	iter := rng.Iter()
+//	for iter.Next() {
+//		fmt.Println(iter.Date())
+//	}
+//
+func (r DateRange) Iter() *DateIter {
+	return &DateIter{next: r.Start, end: r.End}
+}
+
+// DateIter iterates over the Dates in a DateRange. See DateRange.Iter.
+type DateIter struct {
+	next, cur, end Date
+}
+
+func (it *DateIter) Next() bool {
+	if !it.next.Before(it.end) {
+		return false
+	}
+	it.cur = it.next
+	it.next = it.next.AddDays(1)
+	return true
+}
+
+// Date returns the current Date. It is only valid after a call to Next has
+// returned true.
+func (it *DateIter) Date() Date { return it.cur }
+
+// String returns the range as "<start>..<last>", where <last> is the
+// inclusive last day of the range, for example "2024-01-01..2024-03-31". An
+// empty range is written as ending the day before it starts.
+func (r DateRange) String() string {
+	end := r.End
+	if r.IsEmpty() {
+		end = r.Start
+	}
+	return r.Start.String() + ".." + end.AddDays(-1).String()
+}
+
+func (r DateRange) MarshalText() ([]byte, error) {
+	if r == (DateRange{}) {
+		return nil, nil
+	}
+	return []byte(r.String()), nil
+}
+
+func (r *DateRange) UnmarshalText(data []byte) (err error) {
+	if len(data) == 0 {
+		*r = DateRange{}
+		return nil
+	}
+	*r, err = ParseDateRange(string(data))
+	return err
+}
+
+// ParseDateRange parses a range in the form "<start>..<last>", where <last> is
+// the inclusive last day of the range:
+//
+//	ParseDateRange("2024-01-01..2024-03-31") == DateRange{2024-01-01, 2024-04-01}
+//
+// A single date is accepted as a range of one day. <last> may be the day
+// before <start> to express an empty range.
+//
+func ParseDateRange(s string) (r DateRange, err error) {
+	startStr, lastStr := s, s
+	if idx := strings.Index(s, ".."); idx >= 0 {
+		startStr, lastStr = s[:idx], s[idx+2:]
+	}
+
+	start, err := ParseDate(startStr)
+	if err != nil {
+		return r, fmt.Errorf("times: invalid date range %q: %w", s, err)
+	}
+	last, err := ParseDate(lastStr)
+	if err != nil {
+		return r, fmt.Errorf("times: invalid date range %q: %w", s, err)
+	}
+	end := last.AddDays(1)
+	if end.Before(start) {
+		return r, fmt.Errorf("times: invalid date range %q: last day is before start", s)
+	}
+	return DateRange{Start: start, End: end}, nil
+}
+
+// DateSet is a set of Dates, stored as a sorted list of DateRanges that
+// neither overlap nor touch. The zero value is an empty set.
+//
+// The text form is a comma separated list of DateRanges, for example
+// "2024-01-01..2024-01-05,2024-02-01..2024-02-01".
+type DateSet struct {
+	ranges []DateRange
+}
+
+// NewDateSet returns a DateSet containing every Date in ranges. The ranges may
+// overlap and do not need to be sorted.
+func NewDateSet(ranges ...DateRange) DateSet {
+	return DateSet{ranges: normaliseDateRanges(append([]DateRange(nil), ranges...))}
+}
+
+// Ranges returns a copy of the ranges in the set, in order.
+func (s DateSet) Ranges() []DateRange {
+	return append([]DateRange(nil), s.ranges...)
+}
+
+func (s DateSet) IsEmpty() bool { return len(s.ranges) == 0 }
+
+// Len returns the number of days in the set.
+func (s DateSet) Len() (n int) {
+	for _, r := range s.ranges {
+		n += r.Len()
+	}
+	return n
+}
+
+// Bounds returns the smallest DateRange that contains every Date in the set.
+func (s DateSet) Bounds() DateRange {
+	if len(s.ranges) == 0 {
+		return DateRange{}
+	}
+	return DateRange{Start: s.ranges[0].Start, End: s.ranges[len(s.ranges)-1].End}
+}
+
+func (s DateSet) Contains(d Date) bool {
+	idx := s.search(d)
+	return idx < len(s.ranges) && s.ranges[idx].Contains(d)
+}
+
+// ContainsRange reports whether every Date in r is in the set.
+func (s DateSet) ContainsRange(r DateRange) bool {
+	if r.IsEmpty() {
+		return true
+	}
+	idx := s.search(r.Start)
+	return idx < len(s.ranges) && s.ranges[idx].ContainsRange(r)
+}
+
+// Overlaps reports whether any Date in r is in the set.
+func (s DateSet) Overlaps(r DateRange) bool {
+	idx := s.search(r.Start)
+	return idx < len(s.ranges) && s.ranges[idx].Overlaps(r)
+}
+
+// search returns the index of the first range that ends after d.
+func (s DateSet) search(d Date) int {
+	return sort.Search(len(s.ranges), func(i int) bool { return d.Before(s.ranges[i].End) })
+}
+
+// Add adds every Date in r to the set.
+func (s *DateSet) Add(r DateRange) {
+	s.ranges = normaliseDateRanges(append(s.Ranges(), r))
+}
+
+// Remove removes every Date in r from the set.
+func (s *DateSet) Remove(r DateRange) {
+	*s = s.Subtract(NewDateSet(r))
+}
+
+// Union returns the Dates that are in either s or o.
+func (s DateSet) Union(o DateSet) DateSet {
+	return DateSet{ranges: normaliseDateRanges(append(s.Ranges(), o.ranges...))}
+}
+
+// Intersect returns the Dates that are in both s and o.
+func (s DateSet) Intersect(o DateSet) DateSet {
+	var out []DateRange
+	i, j := 0, 0
+	for i < len(s.ranges) && j < len(o.ranges) {
+		if isect, ok := s.ranges[i].Intersect(o.ranges[j]); ok {
+			out = append(out, isect)
+		}
+		if s.ranges[i].End.Before(o.ranges[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return DateSet{ranges: out}
+}
+
+// Subtract returns the Dates in s that are not in o.
+func (s DateSet) Subtract(o DateSet) DateSet {
+	var out []DateRange
+	j := 0
+	for _, cur := range s.ranges {
+		for j < len(o.ranges) && !o.ranges[j].End.After(cur.Start) {
+			j++
+		}
+		for k := j; k < len(o.ranges) && o.ranges[k].Start.Before(cur.End); k++ {
+			if o.ranges[k].Start.After(cur.Start) {
+				out = append(out, DateRange{Start: cur.Start, End: o.ranges[k].Start})
+			}
+			cur.Start = o.ranges[k].End
+			if !cur.Start.Before(cur.End) {
+				break
+			}
+		}
+		if !cur.IsEmpty() {
+			out = append(out, cur)
+		}
+	}
+	return DateSet{ranges: out}
+}
+
+// Each calls fn for each Date in the set, in order, until fn returns false.
+func (s DateSet) Each(fn func(d Date) bool) {
+	for _, r := range s.ranges {
+		for d := r.Start; d.Before(r.End); d = d.AddDays(1) {
+			if !fn(d) {
+				return
+			}
+		}
+	}
+}
+
+// Dates returns a slice containing every Date in the set, in order.
+func (s DateSet) Dates() []Date {
+	out := make([]Date, 0, s.Len())
+	s.Each(func(d Date) bool {
+		out = append(out, d)
+		return true
+	})
+	return out
+}
+
+func (s DateSet) String() string {
+	var sb strings.Builder
+	for i, r := range s.ranges {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(r.String())
+	}
+	return sb.String()
+}
+
+func (s DateSet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *DateSet) UnmarshalText(data []byte) (err error) {
+	*s, err = ParseDateSet(string(data))
+	return err
+}
+
+// ParseDateSet parses a comma separated list of ranges in the form accepted
+// by ParseDateRange. The ranges may overlap and do not need to be sorted. An
+// empty string is an empty set.
+func ParseDateSet(s string) (DateSet, error) {
+	if s == "" {
+		return DateSet{}, nil
+	}
+	parts := strings.Split(s, ",")
+	ranges := make([]DateRange, 0, len(parts))
+	for _, part := range parts {
+		r, err := ParseDateRange(strings.TrimSpace(part))
+		if err != nil {
+			return DateSet{}, err
+		}
+		ranges = append(ranges, r)
+	}
+	return DateSet{ranges: normaliseDateRanges(ranges)}, nil
+}
+
+// normaliseDateRanges sorts ranges in place, drops empty ranges and merges
+// ranges that overlap or touch.
+func normaliseDateRanges(ranges []DateRange) []DateRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start.Before(ranges[j].Start) })
+	out := ranges[:0]
+	for _, r := range ranges {
+		if r.IsEmpty() {
+			continue
+		}
+		if n := len(out); n > 0 {
+			if merged, ok := out[n-1].Merge(r); ok {
+				out[n-1] = merged
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func minDate(a, b Date) Date {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxDate(a, b Date) Date {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,160 @@
+package times
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDateRangeIteration(t *testing.T) {
+	rng := NewDateRange(Date{2020, 2, 27}, Date{2020, 3, 2})
+	ex := []Date{{2020, 2, 27}, {2020, 2, 28}, {2020, 2, 29}, {2020, 3, 1}}
+
+	if rng.Len() != 4 || rng.IsEmpty() {
+		t.Fatal(rng.Len())
+	}
+	if dates := rng.Dates(); !reflect.DeepEqual(dates, ex) {
+		t.Fatal(dates, "!=", ex)
+	}
+
+	var iterd []Date
+	iter := rng.Iter()
+	for iter.Next() {
+		iterd = append(iterd, iter.Date())
+	}
+	if !reflect.DeepEqual(iterd, ex) {
+		t.Fatal(iterd, "!=", ex)
+	}
+
+	var stopped []Date
+	rng.Each(func(d Date) bool { stopped = append(stopped, d); return len(stopped) < 2 })
+	if !reflect.DeepEqual(stopped, ex[:2]) {
+		t.Fatal(stopped, "!=", ex[:2])
+	}
+
+	empty := NewDateRange(Date{2020, 3, 2}, Date{2020, 3, 1})
+	if empty.Len() != 0 || !empty.IsEmpty() || len(empty.Dates()) != 0 || empty.Iter().Next() {
+		t.Fatal()
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	rng := DateRangeOfMonth(Date{2020, 2, 10})
+	if rng != (DateRange{Date{2020, 2, 1}, Date{2020, 3, 1}}) || rng.Len() != 29 {
+		t.Fatal(rng)
+	}
+	for d, ex := range map[Date]bool{
+		{2020, 1, 31}: false,
+		{2020, 2, 1}:  true,
+		{2020, 2, 29}: true,
+		{2020, 3, 1}:  false,
+	} {
+		if rng.Contains(d) != ex {
+			t.Fatal(d, "!=", ex)
+		}
+	}
+}
+
+func TestDateRangeSetOps(t *testing.T) {
+	rng := func(s string) DateRange {
+		r, err := ParseDateRange(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	a, b := rng("2024-01-01..2024-01-10"), rng("2024-01-05..2024-01-20")
+	if !a.Overlaps(b) || a.Overlaps(rng("2024-01-11..2024-01-12")) {
+		t.Fatal()
+	}
+	if isect, ok := a.Intersect(b); !ok || isect != rng("2024-01-05..2024-01-10") {
+		t.Fatal(isect, ok)
+	}
+	if merged, ok := a.Merge(rng("2024-01-11..2024-01-12")); !ok || merged != rng("2024-01-01..2024-01-12") {
+		t.Fatal(merged, ok)
+	}
+	if _, ok := a.Merge(rng("2024-01-12..2024-01-12")); ok {
+		t.Fatal()
+	}
+	if !a.ContainsRange(rng("2024-01-02..2024-01-10")) || a.ContainsRange(b) {
+		t.Fatal()
+	}
+
+	parts := a.Subtract(rng("2024-01-03..2024-01-04"))
+	ex := []DateRange{rng("2024-01-01..2024-01-02"), rng("2024-01-05..2024-01-10")}
+	if !reflect.DeepEqual(parts, ex) {
+		t.Fatal(parts, "!=", ex)
+	}
+	if parts := a.Subtract(rng("2023-12-01..2024-12-01")); len(parts) != 0 {
+		t.Fatal(parts)
+	}
+}
+
+func TestDateRangeText(t *testing.T) {
+	for idx, tc := range []struct {
+		in  string
+		out DateRange
+	}{
+		{"2024-01-01..2024-03-31", DateRange{Date{2024, 1, 1}, Date{2024, 4, 1}}},
+		{"2024-02-29", DateRange{Date{2024, 2, 29}, Date{2024, 3, 1}}},
+		{"2024-01-01..2023-12-31", DateRange{Date{2024, 1, 1}, Date{2024, 1, 1}}},
+	} {
+		var r DateRange
+		if err := r.UnmarshalText([]byte(tc.in)); err != nil {
+			t.Fatal(idx, err)
+		}
+		if r != tc.out {
+			t.Fatal(idx, r, "!=", tc.out)
+		}
+		if s := r.String(); s != tc.in && s != tc.in+".."+tc.in {
+			t.Fatal(idx, s, "!=", tc.in)
+		}
+	}
+
+	for _, in := range []string{"2024-01-01..", "2024-01-01..2023-12-30", "nope"} {
+		if _, err := ParseDateRange(in); err == nil {
+			t.Fatal(in)
+		}
+	}
+}
+
+func TestDateSet(t *testing.T) {
+	set, err := ParseDateSet("2024-02-01..2024-02-10,2024-01-01..2024-01-05,2024-01-06..2024-01-07,2024-02-05..2024-02-06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := set.String(); s != "2024-01-01..2024-01-07,2024-02-01..2024-02-10" {
+		t.Fatal(s)
+	}
+	if set.Len() != 17 || len(set.Dates()) != 17 {
+		t.Fatal(set.Len())
+	}
+	if !set.Contains(Date{2024, 1, 7}) || set.Contains(Date{2024, 1, 8}) || set.Contains(Date{2023, 12, 31}) {
+		t.Fatal()
+	}
+
+	set.Remove(NewDateRange(Date{2024, 1, 3}, Date{2024, 2, 3}))
+	if s := set.String(); s != "2024-01-01..2024-01-02,2024-02-03..2024-02-10" {
+		t.Fatal(s)
+	}
+	set.Add(NewDateRange(Date{2024, 1, 3}, Date{2024, 1, 4}))
+	if s := set.String(); s != "2024-01-01..2024-01-03,2024-02-03..2024-02-10" {
+		t.Fatal(s)
+	}
+
+	other, _ := ParseDateSet("2024-01-02..2024-02-04,2024-02-09")
+	if s := set.Intersect(other).String(); s != "2024-01-02..2024-01-03,2024-02-03..2024-02-04,2024-02-09..2024-02-09" {
+		t.Fatal(s)
+	}
+	if s := set.Subtract(other).String(); s != "2024-01-01..2024-01-01,2024-02-05..2024-02-08,2024-02-10..2024-02-10" {
+		t.Fatal(s)
+	}
+	if s := set.Union(other).String(); s != "2024-01-01..2024-02-10" {
+		t.Fatal(s)
+	}
+
+	var rt DateSet
+	if err := rt.UnmarshalText([]byte(set.String())); err != nil || !reflect.DeepEqual(rt, set) {
+		t.Fatal(rt, err)
+	}
+}
@@ -1,6 +1,7 @@
 package times
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -25,6 +26,11 @@ func (r *RFC3339) UnmarshalJSON(b []byte) (err error) {
 	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
+	return r.UnmarshalText([]byte(s))
+}
+
+func (r *RFC3339) UnmarshalText(b []byte) (err error) {
+	s := string(b)
 	t, err := time.Parse(time.RFC3339Nano, s)
 	if err != nil {
 		t, err = time.Parse(`2006-01-02T15:04:05.999999999Z0700`, s)
@@ -36,6 +42,22 @@ func (r *RFC3339) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (r RFC3339) Value() (driver.Value, error) {
+	return r.Time, nil
+}
+
+func (r *RFC3339) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*r = RFC3339{t}
+		return nil
+	}
+	b, err := scanText(src, "RFC3339")
+	if err != nil {
+		return err
+	}
+	return r.UnmarshalText(b)
+}
+
 func unsafeString(bs []byte) string {
 	return *(*string)(unsafe.Pointer(&bs))
 }
@@ -65,6 +87,37 @@ func (d *DurationString) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (d DurationString) MarshalText() ([]byte, error) {
+	return []byte(d.Duration.String()), nil
+}
+
+func (d *DurationString) UnmarshalText(b []byte) (err error) {
+	td, err := time.ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = DurationString{td}
+	return nil
+}
+
+func (d DurationString) Value() (driver.Value, error) {
+	return d.Duration.String(), nil
+}
+
+// Scan accepts a string in the format accepted by time.ParseDuration, or an
+// integer number of nanoseconds.
+func (d *DurationString) Scan(src interface{}) error {
+	if ns, ok := src.(int64); ok {
+		*d = DurationString{time.Duration(ns)}
+		return nil
+	}
+	b, err := scanText(src, "DurationString")
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalText(b)
+}
+
 // DurationMsecFloat provides a time.Duration that marshals to/from a float
 // representing milliseconds.
 type DurationMsecFloat time.Duration
@@ -92,6 +145,21 @@ func (d *DurationMsecFloat) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (d DurationMsecFloat) MarshalText() ([]byte, error)  { return d.MarshalJSON() }
+func (d *DurationMsecFloat) UnmarshalText(b []byte) error { return d.UnmarshalJSON(b) }
+
+func (d DurationMsecFloat) Value() (driver.Value, error) {
+	return float64(d) / float64(time.Millisecond), nil
+}
+
+func (d *DurationMsecFloat) Scan(src interface{}) error {
+	b, err := scanText(src, "DurationMsecFloat")
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalText(b)
+}
+
 // DurationSecFloat provides a time.Duration that marshals to/from a float
 // representing seconds.
 type DurationSecFloat time.Duration
@@ -119,6 +187,21 @@ func (d *DurationSecFloat) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (d DurationSecFloat) MarshalText() ([]byte, error)  { return d.MarshalJSON() }
+func (d *DurationSecFloat) UnmarshalText(b []byte) error { return d.UnmarshalJSON(b) }
+
+func (d DurationSecFloat) Value() (driver.Value, error) {
+	return float64(d) / float64(time.Second), nil
+}
+
+func (d *DurationSecFloat) Scan(src interface{}) error {
+	b, err := scanText(src, "DurationSecFloat")
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalText(b)
+}
+
 // DurationSecInt64 provides a time.Duration that marshals to/from an int64
 // representing seconds.
 type DurationSecInt64 time.Duration
@@ -147,6 +230,21 @@ func (d *DurationSecInt64) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (d DurationSecInt64) MarshalText() ([]byte, error)  { return d.MarshalJSON() }
+func (d *DurationSecInt64) UnmarshalText(b []byte) error { return d.UnmarshalJSON(b) }
+
+func (d DurationSecInt64) Value() (driver.Value, error) {
+	return int64(time.Duration(d) / time.Second), nil
+}
+
+func (d *DurationSecInt64) Scan(src interface{}) error {
+	b, err := scanText(src, "DurationSecInt64")
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalText(b)
+}
+
 // DurationMsecInt64 provides a time.Duration that marshals to/from an int64
 // representing milliseconds.
 type DurationMsecInt64 time.Duration
@@ -175,6 +273,21 @@ func (d *DurationMsecInt64) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+func (d DurationMsecInt64) MarshalText() ([]byte, error)  { return d.MarshalJSON() }
+func (d *DurationMsecInt64) UnmarshalText(b []byte) error { return d.UnmarshalJSON(b) }
+
+func (d DurationMsecInt64) Value() (driver.Value, error) {
+	return int64(time.Duration(d) / time.Millisecond), nil
+}
+
+func (d *DurationMsecInt64) Scan(src interface{}) error {
+	b, err := scanText(src, "DurationMsecInt64")
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalText(b)
+}
+
 // UnixSecInt provides a time.Time that marshals to/from an int representing
 // the number of whole seconds since the Unix epoch.
 type UnixSecInt time.Time
@@ -201,6 +314,25 @@ func (u *UnixSecInt) UnmarshalJSON(bts []byte) error {
 	return nil
 }
 
+func (u UnixSecInt) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixSecInt) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixSecInt) Value() (driver.Value, error) {
+	return time.Time(u).Unix(), nil
+}
+
+func (u *UnixSecInt) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixSecInt(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixSecInt")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
 // UnixSecInt provides a time.Time that marshals to/from a float representing
 // the number of whole or partial seconds since the Unix epoch.
 type UnixSecFloat time.Time
@@ -226,6 +358,25 @@ func (u *UnixSecFloat) UnmarshalJSON(bts []byte) error {
 	return nil
 }
 
+func (u UnixSecFloat) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixSecFloat) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixSecFloat) Value() (driver.Value, error) {
+	return ToFloat64Secs(time.Time(u)), nil
+}
+
+func (u *UnixSecFloat) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixSecFloat(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixSecFloat")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
 // UnixMsecInt provides a time.Time that marshals to/from an int representing
 // the number of whole milliseconds since the Unix epoch.
 type UnixMsecInt time.Time
@@ -252,6 +403,25 @@ func (u *UnixMsecInt) UnmarshalJSON(bts []byte) error {
 	return nil
 }
 
+func (u UnixMsecInt) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixMsecInt) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixMsecInt) Value() (driver.Value, error) {
+	return time.Time(u).UnixNano() / int64(time.Millisecond), nil
+}
+
+func (u *UnixMsecInt) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixMsecInt(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixMsecInt")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
 // UnixMsecFloat provides a time.Time that marshals to/from a float representing
 // the number of whole or partial milliseconds since the Unix epoch.
 type UnixMsecFloat time.Time
@@ -261,8 +431,11 @@ func (u UnixMsecFloat) Time() time.Time {
 }
 
 func (u UnixMsecFloat) MarshalJSON() ([]byte, error) {
-	ut := float64(time.Time(u).Unix()) + (float64(time.Time(u).Nanosecond()) / 1000000.0)
-	return json.Marshal(ut)
+	return json.Marshal(u.msecs())
+}
+
+func (u UnixMsecFloat) msecs() float64 {
+	return float64(time.Time(u).Unix()) + (float64(time.Time(u).Nanosecond()) / 1000000.0)
 }
 
 func (u *UnixMsecFloat) UnmarshalJSON(bts []byte) error {
@@ -277,3 +450,144 @@ func (u *UnixMsecFloat) UnmarshalJSON(bts []byte) error {
 	*u = UnixMsecFloat(t)
 	return nil
 }
+
+func (u UnixMsecFloat) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixMsecFloat) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixMsecFloat) Value() (driver.Value, error) {
+	return u.msecs(), nil
+}
+
+func (u *UnixMsecFloat) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixMsecFloat(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixMsecFloat")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
+// UnixUsecInt provides a time.Time that marshals to/from an int representing
+// the number of whole microseconds since the Unix epoch.
+type UnixUsecInt time.Time
+
+func (u UnixUsecInt) Time() time.Time {
+	return time.Time(u)
+}
+
+func (u UnixUsecInt) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, unixInt(time.Time(u), time.Microsecond), 10), nil
+}
+
+func (u *UnixUsecInt) UnmarshalJSON(bts []byte) error {
+	t, err := parseUnixInt(bts, time.Microsecond)
+	if err != nil {
+		return err
+	}
+	*u = UnixUsecInt(t)
+	return nil
+}
+
+func (u UnixUsecInt) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixUsecInt) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixUsecInt) Value() (driver.Value, error) {
+	return unixInt(time.Time(u), time.Microsecond), nil
+}
+
+func (u *UnixUsecInt) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixUsecInt(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixUsecInt")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
+// UnixNsecInt provides a time.Time that marshals to/from an int representing
+// the number of nanoseconds since the Unix epoch. Only times between the
+// years 1678 and 2262 can be represented.
+type UnixNsecInt time.Time
+
+func (u UnixNsecInt) Time() time.Time {
+	return time.Time(u)
+}
+
+func (u UnixNsecInt) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, unixInt(time.Time(u), time.Nanosecond), 10), nil
+}
+
+func (u *UnixNsecInt) UnmarshalJSON(bts []byte) error {
+	t, err := parseUnixInt(bts, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+	*u = UnixNsecInt(t)
+	return nil
+}
+
+func (u UnixNsecInt) MarshalText() ([]byte, error)  { return u.MarshalJSON() }
+func (u *UnixNsecInt) UnmarshalText(b []byte) error { return u.UnmarshalJSON(b) }
+
+func (u UnixNsecInt) Value() (driver.Value, error) {
+	return unixInt(time.Time(u), time.Nanosecond), nil
+}
+
+func (u *UnixNsecInt) Scan(src interface{}) error {
+	if t, ok := src.(time.Time); ok {
+		*u = UnixNsecInt(t)
+		return nil
+	}
+	b, err := scanText(src, "UnixNsecInt")
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(b)
+}
+
+// unixInt returns the number of whole units since the Unix epoch, rounding
+// towards negative infinity.
+func unixInt(t time.Time, unit time.Duration) int64 {
+	perSec := int64(time.Second / unit)
+	return t.Unix()*perSec + int64(t.Nanosecond())/int64(unit)
+}
+
+// parseUnixInt parses an integer number of units since the Unix epoch. Floats
+// are accepted, but are truncated to a whole number of units, and may lose
+// precision.
+func parseUnixInt(bts []byte, unit time.Duration) (time.Time, error) {
+	s := unsafeString(bts)
+	iv, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		fv, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil || math.IsNaN(fv) || math.IsInf(fv, 0) || fv >= math.MaxInt64 || fv <= math.MinInt64 {
+			return time.Time{}, fmt.Errorf("input %q is an invalid unix time", s)
+		}
+		iv = int64(fv)
+	}
+	perSec := int64(time.Second / unit)
+	return time.Unix(iv/perSec, (iv%perSec)*int64(unit)).In(time.UTC), nil
+}
+
+// scanText converts a value from a database/sql driver into text so that it
+// can be passed to UnmarshalText.
+func scanText(src interface{}, into string) ([]byte, error) {
+	switch src := src.(type) {
+	case []byte:
+		return src, nil
+	case string:
+		return []byte(src), nil
+	case int64:
+		return strconv.AppendInt(nil, src, 10), nil
+	case float64:
+		return strconv.AppendFloat(nil, src, 'f', -1, 64), nil
+	default:
+		return nil, fmt.Errorf("times: cannot scan %T into %s", src, into)
+	}
+}
@@ -1,7 +1,12 @@
 package times
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -148,3 +153,194 @@ func BenchmarkDurationString(b *testing.B) {
 		}
 	}
 }
+
+func TestUnixIntPrecisions(t *testing.T) {
+	in := time.Date(2018, 1, 1, 12, 0, 1, 123456789, time.UTC)
+	for idx, tc := range []struct {
+		v    interface{}
+		out  interface{}
+		json string
+		ex   time.Time
+	}{
+		{UnixSecInt(in), new(UnixSecInt), "1514808001", time.Date(2018, 1, 1, 12, 0, 1, 0, time.UTC)},
+		{UnixMsecInt(in), new(UnixMsecInt), "1514808001123", time.Date(2018, 1, 1, 12, 0, 1, 123000000, time.UTC)},
+		{UnixUsecInt(in), new(UnixUsecInt), "1514808001123456", time.Date(2018, 1, 1, 12, 0, 1, 123456000, time.UTC)},
+		{UnixNsecInt(in), new(UnixNsecInt), "1514808001123456789", in},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			bts, err := json.Marshal(tc.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(bts) != tc.json {
+				t.Fatal(string(bts), "!=", tc.json)
+			}
+			text, err := tc.v.(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != tc.json {
+				t.Fatal(string(text), "!=", tc.json)
+			}
+
+			if err := json.Unmarshal(bts, tc.out); err != nil {
+				t.Fatal(err)
+			}
+			result := tc.out.(interface{ Time() time.Time }).Time()
+			if d := result.Sub(tc.ex); d > time.Microsecond || d < -time.Microsecond {
+				t.Fatal(result, "!=", tc.ex)
+			}
+
+			value, err := tc.v.(driver.Valuer).Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tc.out.(sql.Scanner).Scan(value); err != nil {
+				t.Fatal(err)
+			}
+			result = tc.out.(interface{ Time() time.Time }).Time()
+			if d := result.Sub(tc.ex); d > time.Microsecond || d < -time.Microsecond {
+				t.Fatal(result, "!=", tc.ex)
+			}
+		})
+	}
+}
+
+func TestUnixMsecFloat(t *testing.T) {
+	in := time.Date(2018, 1, 1, 12, 0, 1, 123456789, time.UTC)
+	bts, err := json.Marshal(UnixMsecFloat(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bts) != "1514808124.456789" {
+		t.Fatal(string(bts))
+	}
+}
+
+func TestUnixNsecIntNegative(t *testing.T) {
+	in := time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)
+	bts, _ := json.Marshal(UnixNsecInt(in))
+	if string(bts) != "-500000000" {
+		t.Fatal(string(bts))
+	}
+	var out UnixNsecInt
+	if err := json.Unmarshal(bts, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Time().Equal(in) {
+		t.Fatal(out.Time())
+	}
+}
+
+func TestRFC3339Text(t *testing.T) {
+	var r RFC3339
+	if err := r.UnmarshalText([]byte("2021-02-04T08:00:59.754+0000")); err != nil {
+		t.Fatal(err)
+	}
+	ex := time.Date(2021, 2, 4, 8, 0, 59, 754000000, time.UTC)
+	if !r.Equal(ex) {
+		t.Fatal(r.Time)
+	}
+	if err := r.Scan("2021-02-04T08:00:59.754Z"); err != nil || !r.Equal(ex) {
+		t.Fatal(r.Time, err)
+	}
+	if err := r.Scan(ex.Add(time.Hour)); err != nil || !r.Equal(ex.Add(time.Hour)) {
+		t.Fatal(r.Time, err)
+	}
+	if err := r.Scan(int64(1)); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDurationCodecs(t *testing.T) {
+	for idx, tc := range []struct {
+		v    interface{}
+		out  interface{}
+		text string
+		sql  driver.Value
+	}{
+		{DurationString{1500 * time.Millisecond}, new(DurationString), "1.5s", "1.5s"},
+		{DurationSecInt64(2 * time.Second), new(DurationSecInt64), "2", int64(2)},
+		{DurationMsecInt64(1500 * time.Millisecond), new(DurationMsecInt64), "1500", int64(1500)},
+		{DurationSecFloat(1500 * time.Millisecond), new(DurationSecFloat), "1.5", 1.5},
+		{DurationMsecFloat(1500 * time.Microsecond), new(DurationMsecFloat), "1.5", 1.5},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			text, err := tc.v.(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != tc.text {
+				t.Fatal(string(text), "!=", tc.text)
+			}
+			value, err := tc.v.(driver.Valuer).Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != tc.sql {
+				t.Fatal(value, "!=", tc.sql)
+			}
+
+			if err := tc.out.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if reflect.ValueOf(tc.out).Elem().Interface() != tc.v {
+				t.Fatal(tc.out, "!=", tc.v)
+			}
+			if err := tc.out.(sql.Scanner).Scan(value); err != nil {
+				t.Fatal(err)
+			}
+			if reflect.ValueOf(tc.out).Elem().Interface() != tc.v {
+				t.Fatal(tc.out, "!=", tc.v)
+			}
+		})
+	}
+}
+
+func TestNullCodecs(t *testing.T) {
+	var v struct {
+		A NullUnixSecInt
+		B NullDurationString
+		C NullRFC3339
+	}
+	if err := json.Unmarshal([]byte(`{"A":null,"B":"","C":"2021-02-04T08:00:59Z"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.A.Valid || v.B.Valid || !v.C.Valid {
+		t.Fatal(v)
+	}
+	bts, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bts) != `{"A":null,"B":null,"C":"2021-02-04T08:00:59Z"}` {
+		t.Fatal(string(bts))
+	}
+
+	if err := json.Unmarshal([]byte(`{"A":1514808000,"B":"1m"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if !v.A.Valid || !v.A.Time().Equal(time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC)) || !v.B.Valid || v.B.Duration != time.Minute {
+		t.Fatal(v)
+	}
+
+	var n NullUnixMsecInt
+	for _, src := range []interface{}{nil, "", []byte{}} {
+		n.Valid = true
+		if err := n.Scan(src); err != nil || n.Valid {
+			t.Fatal(src, err)
+		}
+	}
+	if value, err := n.Value(); value != nil || err != nil {
+		t.Fatal(value, err)
+	}
+	if text, err := n.MarshalText(); len(text) != 0 || err != nil {
+		t.Fatal(text, err)
+	}
+	if err := n.Scan(int64(1500)); err != nil || !n.Valid || !n.Time().Equal(time.Unix(1, 500000000)) {
+		t.Fatal(n, err)
+	}
+	if err := n.UnmarshalText([]byte("nope")); err == nil || n.Valid {
+		t.Fatal(n, err)
+	}
+}
@@ -1,6 +1,11 @@
 package times
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type TimeDiff struct {
 	Years       int
@@ -74,3 +79,202 @@ func Diff(t1, t2 time.Time) TimeDiff {
 
 	return diff
 }
+
+func (d TimeDiff) IsZero() bool {
+	return d == TimeDiff{} || d == TimeDiff{Negative: true}
+}
+
+// String returns a compact representation of the TimeDiff, similar to
+// time.Duration.String(), for example "-1y2mo3d4h5m6.5s". Zero fields are
+// omitted; a zero TimeDiff is "0s".
+func (d TimeDiff) String() string {
+	if d.IsZero() {
+		return "0s"
+	}
+
+	var sb strings.Builder
+	if d.Negative {
+		sb.WriteByte('-')
+	}
+	for _, part := range []struct {
+		v    int
+		unit string
+	}{
+		{d.Years, "y"}, {d.Months, "mo"}, {d.Days, "d"}, {d.Hours, "h"}, {d.Minutes, "m"},
+	} {
+		if part.v != 0 {
+			sb.WriteString(strconv.Itoa(part.v))
+			sb.WriteString(part.unit)
+		}
+	}
+	if d.Seconds != 0 || d.Nanoseconds != 0 {
+		writeSeconds(&sb, d.Seconds, d.Nanoseconds)
+		sb.WriteByte('s')
+	}
+	return sb.String()
+}
+
+// ISO8601 returns the TimeDiff as an ISO 8601 duration, for example
+// "P3Y2M1DT4H5M6.5S". A zero TimeDiff is "PT0S". Negative TimeDiffs are
+// prefixed with '-', which is a common extension to ISO 8601.
+//
+// See ParseTimeDiff for the complement.
+func (d TimeDiff) ISO8601() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+
+	var sb strings.Builder
+	if d.Negative {
+		sb.WriteByte('-')
+	}
+	sb.WriteByte('P')
+	for _, part := range []struct {
+		v    int
+		unit byte
+	}{
+		{d.Years, 'Y'}, {d.Months, 'M'}, {d.Days, 'D'},
+	} {
+		if part.v != 0 {
+			sb.WriteString(strconv.Itoa(part.v))
+			sb.WriteByte(part.unit)
+		}
+	}
+	if d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0 || d.Nanoseconds != 0 {
+		sb.WriteByte('T')
+		if d.Hours != 0 {
+			sb.WriteString(strconv.Itoa(d.Hours))
+			sb.WriteByte('H')
+		}
+		if d.Minutes != 0 {
+			sb.WriteString(strconv.Itoa(d.Minutes))
+			sb.WriteByte('M')
+		}
+		if d.Seconds != 0 || d.Nanoseconds != 0 {
+			writeSeconds(&sb, d.Seconds, d.Nanoseconds)
+			sb.WriteByte('S')
+		}
+	}
+	return sb.String()
+}
+
+func writeSeconds(sb *strings.Builder, secs, nsecs int) {
+	sb.WriteString(strconv.Itoa(secs))
+	if nsecs != 0 {
+		frac := fmt.Sprintf("%09d", nsecs)
+		sb.WriteByte('.')
+		sb.WriteString(strings.TrimRight(frac, "0"))
+	}
+}
+
+// ParseTimeDiff parses an ISO 8601 duration in the format produced by
+// TimeDiff.ISO8601, i.e. "P3Y2M1DT4H5M6.5S". Weeks ("P2W") are converted to
+// days. The seconds may have a fraction of up to 9 digits, separated by '.'
+// or ','. A leading '-' produces a negative TimeDiff.
+func ParseTimeDiff(s string) (d TimeDiff, err error) {
+	in := s
+	fail := func(msg string) (TimeDiff, error) {
+		return TimeDiff{}, fmt.Errorf("times: invalid ISO 8601 duration %q: %s", in, msg)
+	}
+
+	if strings.HasPrefix(s, "-") {
+		d.Negative, s = true, s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return fail("expected 'P'")
+	}
+	s = s[1:]
+
+	const designators = "YMWDTHMS"
+	inTime, last := false, -1
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return fail("unexpected 'T'")
+			}
+			inTime, s = true, s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return fail("expected number followed by designator")
+		}
+		v, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return fail(err.Error())
+		}
+
+		var nsecs int
+		if s[i] == '.' || s[i] == ',' {
+			j := i + 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			frac := s[i+1 : j]
+			if frac == "" || len(frac) > 9 || j == len(s) || s[j] != 'S' {
+				return fail("invalid fraction")
+			}
+			nsecs, _ = strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+			i = j
+		}
+
+		pos := strings.IndexByte(designators, s[i])
+		if inTime && s[i] == 'M' {
+			pos = strings.LastIndexByte(designators, 'M')
+		}
+		if pos < 0 || pos <= last || (pos > 4) != inTime {
+			return fail(fmt.Sprintf("unexpected designator %q", s[i]))
+		}
+		last = pos
+
+		switch s[i] {
+		case 'Y':
+			d.Years = v
+		case 'W':
+			d.Days += v * 7
+		case 'D':
+			d.Days += v
+		case 'H':
+			d.Hours = v
+		case 'S':
+			d.Seconds, d.Nanoseconds = v, nsecs
+		case 'M':
+			if inTime {
+				d.Minutes = v
+			} else {
+				d.Months = v
+			}
+		}
+		s = s[i+1:]
+	}
+	if last < 0 {
+		return fail("missing duration")
+	}
+	return d, nil
+}
+
+// AddDiff returns t with the TimeDiff applied. If d is Negative, each field is
+// subtracted instead. All fields are applied at once using time.Date, so
+// overflowing days are normalised in the same way as time.AddDate: adding one
+// month to 31 January is 3 March (or 2 March in a leap year).
+//
+// AddDiff(t1, Diff(t1, t2)) == t2 when t1 is not after t2 and the day of t1
+// exists in the month it lands in. If t1 is after t2, the result may be off
+// by a few days when months are involved, as Diff always measures forward
+// from the earlier time.
+func AddDiff(t time.Time, d TimeDiff) time.Time {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+	y, mo, dy := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(
+		y+sign*d.Years, mo+time.Month(sign*d.Months), dy+sign*d.Days,
+		h+sign*d.Hours, mi+sign*d.Minutes, s+sign*d.Seconds, t.Nanosecond()+sign*d.Nanoseconds,
+		t.Location())
+}
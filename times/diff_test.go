@@ -1,6 +1,7 @@
 package times
 
 import (
+	"fmt"
 	"testing"
 	"time"
 )
@@ -43,3 +44,134 @@ func TestDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeDiffString(t *testing.T) {
+	for idx, tc := range []struct {
+		in      TimeDiff
+		str     string
+		iso     string
+		human   string
+		relShrt string
+	}{
+		{TimeDiff{}, "0s", "PT0S", "0 seconds", "now"},
+		{TimeDiff{Negative: true}, "0s", "PT0S", "0 seconds", "now"},
+		{TimeDiff{Years: 3, Months: 2}, "3y2mo", "P3Y2M", "3 years, 2 months", "in 3y 2mo"},
+		{TimeDiff{Years: 1, Days: 3}, "1y3d", "P1Y3D", "1 year", "in 1y"},
+		{TimeDiff{Minutes: 5}, "5m", "PT5M", "5 minutes", "in 5m"},
+		{TimeDiff{Hours: 2, Minutes: 1, Negative: true}, "-2h1m", "-PT2H1M", "-2 hours, 1 minute", "2h 1m ago"},
+		{TimeDiff{Days: 1, Seconds: 6, Nanoseconds: 500000000}, "1d6.5s", "P1DT6.5S", "1 day", "in 1d"},
+		{TimeDiff{Nanoseconds: 1}, "0.000000001s", "PT0.000000001S", "0 seconds", "now"},
+		{TimeDiff{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}, "1y2mo3d4h5m6s", "P1Y2M3DT4H5M6S", "1 year, 2 months", "in 1y 2mo"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.in.String(); result != tc.str {
+				t.Fatal(result, "!=", tc.str)
+			}
+			if result := tc.in.ISO8601(); result != tc.iso {
+				t.Fatal(result, "!=", tc.iso)
+			}
+			if result := tc.in.Humanise(); result != tc.human {
+				t.Fatal(result, "!=", tc.human)
+			}
+			if result := (Humaniser{Precision: 2, Short: true, Relative: true}).Format(tc.in); result != tc.relShrt {
+				t.Fatal(result, "!=", tc.relShrt)
+			}
+
+			parsed, err := ParseTimeDiff(tc.iso)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.in.IsZero() && parsed != tc.in {
+				t.Fatalf("%+v != %+v", parsed, tc.in)
+			}
+		})
+	}
+}
+
+func TestHumaniser(t *testing.T) {
+	d := TimeDiff{Years: 3, Months: 2, Days: 1, Hours: 4}
+	for idx, tc := range []struct {
+		h  Humaniser
+		ex string
+	}{
+		{Humaniser{}, "3 years, 2 months, 1 day, 4 hours"},
+		{Humaniser{Precision: 1}, "3 years"},
+		{Humaniser{Precision: 3, Separator: " and "}, "3 years and 2 months and 1 day"},
+		{Humaniser{Short: true}, "3y 2mo 1d 4h"},
+		{Humaniser{Short: true, Separator: ""}, "3y 2mo 1d 4h"},
+		{Humaniser{Relative: true, Precision: 1}, "in 3 years"},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			if result := tc.h.Format(d); result != tc.ex {
+				t.Fatal(result, "!=", tc.ex)
+			}
+		})
+	}
+
+	if result := (Humaniser{Zero: "just now"}).Format(TimeDiff{}); result != "just now" {
+		t.Fatal(result)
+	}
+}
+
+func TestParseTimeDiff(t *testing.T) {
+	for idx, tc := range []struct {
+		in string
+		ex TimeDiff
+	}{
+		{"P2W", TimeDiff{Days: 14}},
+		{"P1W2D", TimeDiff{Days: 9}},
+		{"PT0,25S", TimeDiff{Nanoseconds: 250000000}},
+		{"P1M", TimeDiff{Months: 1}},
+		{"PT1M", TimeDiff{Minutes: 1}},
+		{"-P1D", TimeDiff{Days: 1, Negative: true}},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result, err := ParseTimeDiff(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != tc.ex {
+				t.Fatalf("%+v != %+v", result, tc.ex)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "P", "PT", "1D", "P1", "PD", "P1H", "PT1D", "P1D1Y", "P1.5D", "PT1.S", "PT1.0000000001S", "P1DT", "P-1D"} {
+		if _, err := ParseTimeDiff(in); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
+
+func TestAddDiff(t *testing.T) {
+	mdt := func(yr int, mon time.Month, d, h, min, s, ns int) time.Time {
+		return time.Date(yr, mon, d, h, min, s, ns, time.UTC)
+	}
+
+	for idx, tc := range []struct {
+		a, b time.Time
+	}{
+		{mdt(2018, 1, 1, 12, 0, 0, 0), mdt(2018, 1, 1, 12, 0, 0, 1)},
+		{mdt(2018, 2, 2, 2, 2, 2, 2), mdt(2019, 1, 1, 1, 1, 1, 1)},
+		{mdt(2018, 1, 1, 12, 0, 0, 1), mdt(2018, 1, 1, 12, 0, 0, 0)},
+		{mdt(2015, 5, 1, 0, 0, 0, 0), mdt(2016, 6, 2, 1, 1, 1, 1)},
+		{mdt(2005, 12, 31, 23, 59, 0, 0), mdt(2006, 1, 1, 0, 0, 0, 0)},
+		{mdt(2017, 2, 11, 0, 0, 0, 0), mdt(2018, 1, 12, 0, 0, 0, 0)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			diff := Diff(tc.a, tc.b)
+			if result := AddDiff(tc.a, diff); !result.Equal(tc.b) {
+				t.Fatal(result, "!=", tc.b, diff)
+			}
+		})
+	}
+
+	result := AddDiff(mdt(2021, 1, 31, 0, 0, 0, 0), TimeDiff{Months: 1})
+	if !result.Equal(mdt(2021, 3, 3, 0, 0, 0, 0)) {
+		t.Fatal(result)
+	}
+	result = AddDiff(mdt(2021, 3, 15, 12, 0, 0, 0), TimeDiff{Months: 1, Days: 2, Hours: 13, Negative: true})
+	if !result.Equal(mdt(2021, 2, 12, 23, 0, 0, 0)) {
+		t.Fatal(result)
+	}
+}
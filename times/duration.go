@@ -1,6 +1,8 @@
 package times
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -42,11 +44,66 @@ func FirstMondayOfWeek(t time.Time) time.Time {
 	return s
 }
 
+// FirstDayOfWeek returns midnight on the most recent day on or before t that
+// falls on the 'start' weekday, in t's location. Use time.Sunday for weeks
+// that start on a Sunday:
+//
+//	FirstDayOfWeek("2020-01-01", time.Monday) == "2019-12-30"
+//	FirstDayOfWeek("2020-01-01", time.Sunday) == "2019-12-29"
+//
+func FirstDayOfWeek(t time.Time, start time.Weekday) time.Time {
+	diff := (int(t.Weekday()) - int(start) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-diff, 0, 0, 0, 0, t.Location())
+}
+
+// FormatISOWeek formats the ISO 8601 week that contains t, for example
+// "2024-W07". The year is the ISO week-numbering year, which may differ from
+// t.Year() for days near the start or end of the year.
+func FormatISOWeek(t time.Time) string {
+	y, w := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", y, w)
+}
+
+// ParseISOWeek parses an ISO 8601 week in the format "2024-W07" and returns
+// midnight on the Monday that starts the week, in loc. If loc is nil, UTC is
+// used.
+func ParseISOWeek(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if len(s) != 8 || s[4] != '-' || s[5] != 'W' {
+		return time.Time{}, fmt.Errorf("times: invalid ISO week %q; expected format '2024-W07'", s)
+	}
+	y, err := strconv.Atoi(s[:4])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("times: invalid ISO week %q; expected format '2024-W07'", s)
+	}
+	w, err := strconv.Atoi(s[6:])
+	if err != nil || w < 1 || w > 53 {
+		return time.Time{}, fmt.Errorf("times: invalid ISO week %q; expected format '2024-W07'", s)
+	}
+
+	// January 4th is always in week 1:
+	out := FirstDayOfWeek(time.Date(y, 1, 4, 0, 0, 0, 0, loc), time.Monday)
+	out = time.Date(out.Year(), out.Month(), out.Day()+(w-1)*7, 0, 0, 0, 0, loc)
+	if oy, ow := out.ISOWeek(); oy != y || ow != w {
+		return time.Time{}, fmt.Errorf("times: invalid ISO week %q; year %d does not have week %d", s, y, w)
+	}
+	return out, nil
+}
+
 func TruncateWeeks(t time.Time, n int) time.Time {
 	p := PeriodWeeks(t, n)
 	return PeriodWeeksTime(p, n, t.Location())
 }
 
+// TruncateWeeksFrom rounds t down to the start of the period of n weeks that
+// contains it, where weeks start on the 'start' weekday.
+func TruncateWeeksFrom(t time.Time, n int, start time.Weekday) time.Time {
+	p := PeriodWeeksFrom(t, n, start)
+	return PeriodWeeksTimeFrom(p, n, start, t.Location())
+}
+
 // PeriodWeeks returns a monotonically increasing/decreasing integer that
 // represents a period of n weeks since the Unix epoch.
 func PeriodWeeks(t time.Time, n int) int {
@@ -63,6 +120,19 @@ func PeriodWeeks(t time.Time, n int) int {
 	return gap / n
 }
 
+// PeriodWeeksFrom returns a monotonically increasing/decreasing integer that
+// represents a period of n weeks since the week containing the Unix epoch,
+// where weeks start on the 'start' weekday.
+//
+// Week boundaries are midnights in t's location.
+func PeriodWeeksFrom(t time.Time, n int, start time.Weekday) int {
+	if n <= 0 {
+		n = 1
+	}
+	days := civilDays(t) + epochWeekOffset(start)
+	return floorDiv(floorDiv(days, 7), n)
+}
+
 // PeriodWeeksTime returns a time for the integer identifying the period of
 // n weeks since the Unix epoch.
 func PeriodWeeksTime(p int, n int, loc *time.Location) time.Time {
@@ -75,6 +145,40 @@ func PeriodWeeksTime(p int, n int, loc *time.Location) time.Time {
 	return out
 }
 
+// PeriodWeeksTimeFrom returns midnight in loc at the start of the period
+// identified by p, as returned by PeriodWeeksFrom.
+func PeriodWeeksTimeFrom(p int, n int, start time.Weekday, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if n <= 0 {
+		n = 1
+	}
+	days := p*n*7 - epochWeekOffset(start)
+	return time.Date(1970, 1, 1+days, 0, 0, 0, 0, loc)
+}
+
+// epochWeekOffset returns the number of days between the start of the week
+// that contains the Unix epoch (a Thursday) and the epoch itself.
+func epochWeekOffset(start time.Weekday) int {
+	return (int(time.Thursday) - int(start) + 7) % 7
+}
+
+// civilDays returns the number of calendar days between the Unix epoch and
+// the date of t in t's location.
+func civilDays(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+func floorDiv(n, d int) int {
+	q := n / d
+	if (n%d != 0) && ((n < 0) != (d < 0)) {
+		q--
+	}
+	return q
+}
+
 func TruncateMonth(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
 }
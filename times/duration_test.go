@@ -363,3 +363,84 @@ func BenchmarkPeriodMonths2(b *testing.B) {
 		benchPeriod = PeriodMonths(tm, 2)
 	}
 }
+
+func TestFirstDayOfWeekSunday(t *testing.T) {
+	start := time.Date(2000, 01, 01, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 1000; i++ {
+		f := start.AddDate(0, 0, i).Add(time.Duration(rand.Intn(24*60)) * time.Minute)
+		n := FirstDayOfWeek(f, time.Sunday)
+		if n.Weekday() != time.Sunday {
+			t.Fatal(f.String())
+		}
+		if n.After(f) || f.Sub(n) >= 7*24*time.Hour {
+			t.Fatal(f.String(), n.String())
+		}
+	}
+}
+
+func TestTruncateWeeksFrom(t *testing.T) {
+	tz := time.FixedZone("+10:00", 36000)
+
+	for idx, tc := range []struct {
+		in    time.Time
+		n     int
+		start time.Weekday
+		out   time.Time
+	}{
+		{time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), 1, time.Monday, time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), 1, time.Sunday, time.Date(2019, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC), 1, time.Sunday, time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2020, 1, 4, 23, 59, 59, 0, time.UTC), 1, time.Sunday, time.Date(2019, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 1, time.Thursday, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), 1, time.Thursday, time.Date(1969, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{time.Date(1970, 1, 14, 0, 0, 0, 0, time.UTC), 2, time.Sunday, time.Date(1970, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{time.Date(1969, 12, 16, 0, 0, 0, 0, time.UTC), 2, time.Monday, time.Date(1969, 12, 15, 0, 0, 0, 0, time.UTC)},
+
+		// Week boundaries are local midnights:
+		{time.Date(2017, 7, 1, 0, 0, 0, 0, tz), 3, time.Monday, time.Date(2017, 6, 26, 0, 0, 0, 0, tz)},
+		{time.Date(2020, 1, 6, 5, 0, 0, 0, tz), 1, time.Monday, time.Date(2020, 1, 6, 0, 0, 0, 0, tz)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result := TruncateWeeksFrom(tc.in, tc.n, tc.start)
+			if !result.Equal(tc.out) {
+				t.Fatal(result, "!=", tc.out)
+			}
+			p := PeriodWeeksFrom(tc.in, tc.n, tc.start)
+			if next := PeriodWeeksTimeFrom(p+1, tc.n, tc.start, tc.in.Location()); !next.After(tc.in) {
+				t.Fatal(next, "is not after", tc.in)
+			}
+		})
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	for idx, tc := range []struct {
+		in     time.Time
+		out    string
+		monday time.Time
+	}{
+		{time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), "2024-W07", time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "2020-W53", time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC), "2020-W01", time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			result := FormatISOWeek(tc.in)
+			if result != tc.out {
+				t.Fatal(result, "!=", tc.out)
+			}
+			monday, err := ParseISOWeek(result, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !monday.Equal(tc.monday) {
+				t.Fatal(monday, "!=", tc.monday)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "2024-07", "2024-W00", "2024-W54", "2021-W53", "2024W07x", "abcd-W01"} {
+		if _, err := ParseISOWeek(in, nil); err == nil {
+			t.Fatal(in, "did not fail")
+		}
+	}
+}
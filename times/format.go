@@ -1,6 +1,8 @@
 package times
 
 import (
+	"encoding/binary"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -10,8 +12,33 @@ import (
 //
 // "2020-01-01T12:00:00.000000001Z" >= "2020-01-01T12:00:00.000000000Z"
 //
+// Times are converted to UTC. Strings are only comparable for years 0 to
+// 9999. See ParseComparableRFC3339 for the inverse.
+//
 func TimeToComparableRFC3339(tm time.Time) string {
-	const trail = ".000000000Z"
+	return comparableRFC3339(tm, 9)
+}
+
+// TimeToComparableRFC3339Sec is like TimeToComparableRFC3339, but truncates
+// to the second and omits the fraction: "2020-01-01T12:00:00Z".
+func TimeToComparableRFC3339Sec(tm time.Time) string {
+	return comparableRFC3339(tm, 0)
+}
+
+// TimeToComparableRFC3339Milli is like TimeToComparableRFC3339, but truncates
+// to the millisecond: "2020-01-01T12:00:00.000Z".
+func TimeToComparableRFC3339Milli(tm time.Time) string {
+	return comparableRFC3339(tm, 3)
+}
+
+// TimeToComparableRFC3339Micro is like TimeToComparableRFC3339, but truncates
+// to the microsecond: "2020-01-01T12:00:00.000000Z".
+func TimeToComparableRFC3339Micro(tm time.Time) string {
+	return comparableRFC3339(tm, 6)
+}
+
+func comparableRFC3339(tm time.Time, places int) string {
+	const trail = ".000000000"
 
 	if tm.Location() != time.UTC {
 		tm = tm.In(time.UTC)
@@ -20,8 +47,118 @@ func TimeToComparableRFC3339(tm time.Time) string {
 	tstr := tm.Format("2006-01-02T15:04:05.999999999")
 	idx := strings.IndexByte(tstr, '.')
 	if idx < 0 {
-		return tstr + trail
+		idx = len(tstr)
+		tstr += trail
+	} else {
+		tstr += trail[len(tstr)-idx:]
+	}
+	if places == 0 {
+		return tstr[:idx] + "Z"
 	}
-	places := (len(tstr) - idx)
-	return tstr + trail[places:]
+	return tstr[:idx+1+places] + "Z"
 }
+
+// ParseComparableRFC3339 parses a string produced by TimeToComparableRFC3339
+// or any of its fixed-precision variants. The result is in UTC.
+func ParseComparableRFC3339(s string) (time.Time, error) {
+	switch len(s) {
+	case 20, 24, 27, 30:
+	default:
+		return time.Time{}, fmt.Errorf("times: invalid comparable RFC3339 time %q", s)
+	}
+	if s[len(s)-1] != 'Z' || (len(s) > 20 && s[19] != '.') {
+		return time.Time{}, fmt.Errorf("times: invalid comparable RFC3339 time %q", s)
+	}
+	tm, err := time.Parse("2006-01-02T15:04:05.999999999Z", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("times: invalid comparable RFC3339 time %q: %w", s, err)
+	}
+	return tm, nil
+}
+
+// ComparableBytesSize is the length of the key returned by
+// TimeToComparableBytes.
+const ComparableBytesSize = 8
+
+// TimeToComparableBytes returns an 8 byte key for tm that sorts bytewise in
+// the same order as the times. The key is tm.UnixNano() as a big-endian
+// int64 with the sign bit flipped, so it is only defined for the years 1678
+// to 2262. The zone is not preserved.
+func TimeToComparableBytes(tm time.Time) []byte {
+	return AppendComparableBytes(make([]byte, 0, ComparableBytesSize), tm)
+}
+
+// AppendComparableBytes appends the key returned by TimeToComparableBytes to
+// buf.
+func AppendComparableBytes(buf []byte, tm time.Time) []byte {
+	var b [ComparableBytesSize]byte
+	binary.BigEndian.PutUint64(b[:], comparableUint(tm))
+	return append(buf, b[:]...)
+}
+
+// TimeFromComparableBytes is the inverse of TimeToComparableBytes. The result
+// is in UTC.
+func TimeFromComparableBytes(b []byte) (time.Time, error) {
+	if len(b) != ComparableBytesSize {
+		return time.Time{}, fmt.Errorf("times: comparable key must be %d bytes, found %d", ComparableBytesSize, len(b))
+	}
+	return timeFromComparableUint(binary.BigEndian.Uint64(b)), nil
+}
+
+// comparableBase32 is Crockford's base32 alphabet, which is in ASCII order.
+const comparableBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ComparableBase32Size is the length of the string returned by
+// TimeToComparableBase32.
+const ComparableBase32Size = 13
+
+// TimeToComparableBase32 encodes the key returned by TimeToComparableBytes as
+// 13 characters of Crockford's base32, which sort in the same order as the
+// times: "0000000000000" is the earliest representable time.
+func TimeToComparableBase32(tm time.Time) string {
+	var out [ComparableBase32Size]byte
+	v := comparableUint(tm)
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = comparableBase32[v&0x1f]
+		v >>= 5
+	}
+	return string(out[:])
+}
+
+// TimeFromComparableBase32 is the inverse of TimeToComparableBase32. Decoding
+// is case-insensitive and, as with Crockford's base32, accepts 'O' for '0' and
+// 'I' or 'L' for '1'. The result is in UTC.
+func TimeFromComparableBase32(s string) (time.Time, error) {
+	if len(s) != ComparableBase32Size {
+		return time.Time{}, fmt.Errorf("times: comparable base32 time %q must be %d characters", s, ComparableBase32Size)
+	}
+	var v uint64
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		switch c {
+		case 'O':
+			c = '0'
+		case 'I', 'L':
+			c = '1'
+		}
+		d := strings.IndexByte(comparableBase32, c)
+		if d < 0 || (i == 0 && d > 0xf) {
+			return time.Time{}, fmt.Errorf("times: invalid comparable base32 time %q", s)
+		}
+		v = v<<5 | uint64(d)
+	}
+	return timeFromComparableUint(v), nil
+}
+
+func comparableUint(tm time.Time) uint64 {
+	return uint64(tm.UnixNano()) ^ (1 << 63)
+}
+
+func timeFromComparableUint(v uint64) time.Time {
+	n := int64(v ^ (1 << 63))
+	return time.Unix(n/int64(time.Second), n%int64(time.Second)).UTC()
+}
+
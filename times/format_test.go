@@ -1,7 +1,9 @@
 package times
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -46,3 +48,91 @@ func TestTimeToComparableRFC3339(t *testing.T) {
 		})
 	}
 }
+
+func TestComparableRFC3339Precision(t *testing.T) {
+	tm := time.Date(2020, 1, 1, 12, 0, 0, 123_456_789, time.FixedZone("yep", 3600))
+
+	for idx, tc := range []struct {
+		fn  func(time.Time) string
+		out string
+		rt  time.Time
+	}{
+		{TimeToComparableRFC3339Sec, "2020-01-01T11:00:00Z", time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{TimeToComparableRFC3339Milli, "2020-01-01T11:00:00.123Z", time.Date(2020, 1, 1, 11, 0, 0, 123_000_000, time.UTC)},
+		{TimeToComparableRFC3339Micro, "2020-01-01T11:00:00.123456Z", time.Date(2020, 1, 1, 11, 0, 0, 123_456_000, time.UTC)},
+		{TimeToComparableRFC3339, "2020-01-01T11:00:00.123456789Z", time.Date(2020, 1, 1, 11, 0, 0, 123_456_789, time.UTC)},
+	} {
+		result := tc.fn(tm)
+		if result != tc.out {
+			t.Fatal(idx, result, "!=", tc.out)
+		}
+		rt, err := ParseComparableRFC3339(result)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if rt != tc.rt {
+			t.Fatal(idx, rt, "!=", tc.rt)
+		}
+	}
+
+	if s := TimeToComparableRFC3339Milli(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); s != "2020-01-01T00:00:00.000Z" {
+		t.Fatal(s)
+	}
+
+	for _, in := range []string{
+		"2020-01-01T11:00:00.12Z",
+		"2020-01-01T11:00:00.123+00",
+		"2020-01-01 11:00:00.123Z",
+		"2020-13-01T11:00:00Z",
+	} {
+		if _, err := ParseComparableRFC3339(in); err == nil {
+			t.Fatal(in)
+		}
+	}
+}
+
+func TestComparableBytes(t *testing.T) {
+	tms := []time.Time{
+		time.Date(1678, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 999_999_999, time.UTC),
+		time.Unix(0, 0),
+		time.Unix(0, 1),
+		time.Date(2020, 1, 1, 12, 0, 0, 0, time.FixedZone("yep", 3600)),
+		time.Date(2262, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var prevBytes []byte
+	var prevStr string
+	for idx, tm := range tms {
+		b := TimeToComparableBytes(tm)
+		s := TimeToComparableBase32(tm)
+		if len(b) != ComparableBytesSize || len(s) != ComparableBase32Size {
+			t.Fatal(idx, len(b), len(s))
+		}
+		if idx > 0 && (bytes.Compare(prevBytes, b) >= 0 || prevStr >= s) {
+			t.Fatal(idx, prevStr, s)
+		}
+		prevBytes, prevStr = b, s
+
+		rt, err := TimeFromComparableBytes(b)
+		if err != nil || !rt.Equal(tm) || rt.Location() != time.UTC {
+			t.Fatal(idx, rt, err)
+		}
+		rt, err = TimeFromComparableBase32(strings.ToLower(s))
+		if err != nil || !rt.Equal(tm) {
+			t.Fatal(idx, rt, err)
+		}
+	}
+
+	if s := TimeToComparableBase32(time.Unix(0, 0)); s != "8000000000000" {
+		t.Fatal(s)
+	}
+	for _, in := range []string{"800000000000", "G000000000000", "80000000000U0"} {
+		if _, err := TimeFromComparableBase32(in); err == nil {
+			t.Fatal(in)
+		}
+	}
+	if _, err := TimeFromComparableBytes([]byte{1, 2, 3}); err == nil {
+		t.Fatal()
+	}
+}
@@ -0,0 +1,121 @@
+package times
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Humaniser formats a TimeDiff for people to read, for example
+// "3 years, 2 months", "in 5 minutes" or "2h ago".
+//
+// The zero value formats every non-zero field from Years to Seconds in full,
+// separated by ", ". Nanoseconds are never shown; values are truncated, not
+// rounded.
+type Humaniser struct {
+	// Precision limits the number of fields shown, starting from the largest
+	// non-zero field. Zero means no limit. Once the first non-zero field is
+	// found, the fields that follow it count towards the limit even if they
+	// are zero, so "1 year, 0 months, 3 days" with a Precision of 2 is
+	// "1 year" rather than "1 year, 3 days".
+	Precision int
+
+	// Short uses abbreviated units ("2h 5m") rather than words ("2 hours,
+	// 5 minutes"). If Separator is empty, fields are separated by a space.
+	Short bool
+
+	// Relative describes the TimeDiff relative to now: positive TimeDiffs are
+	// in the future ("in 5 minutes") and negative TimeDiffs are in the past
+	// ("5 minutes ago"), which matches Diff(now, t).
+	Relative bool
+
+	// Separator is placed between each field. Defaults to ", ", or " " if
+	// Short is true.
+	Separator string
+
+	// Zero is returned for a TimeDiff that has no fields to show. Defaults
+	// to "now" if Relative is true, otherwise "0 seconds" (or "0s" if Short
+	// is true).
+	Zero string
+}
+
+var humanUnits = []struct {
+	short, singular, plural string
+}{
+	{"y", "year", "years"},
+	{"mo", "month", "months"},
+	{"d", "day", "days"},
+	{"h", "hour", "hours"},
+	{"m", "minute", "minutes"},
+	{"s", "second", "seconds"},
+}
+
+func (h Humaniser) Format(d TimeDiff) string {
+	fields := [...]int{d.Years, d.Months, d.Days, d.Hours, d.Minutes, d.Seconds}
+
+	sep := h.Separator
+	if sep == "" {
+		sep = ", "
+		if h.Short {
+			sep = " "
+		}
+	}
+
+	var sb strings.Builder
+	var shown, counted int
+	for i, v := range fields {
+		if v == 0 && counted == 0 {
+			continue
+		}
+		if h.Precision > 0 && counted >= h.Precision {
+			break
+		}
+		counted++
+		if v == 0 {
+			continue
+		}
+
+		if shown > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(strconv.Itoa(v))
+		unit := humanUnits[i]
+		if h.Short {
+			sb.WriteString(unit.short)
+		} else if v == 1 {
+			sb.WriteString(" " + unit.singular)
+		} else {
+			sb.WriteString(" " + unit.plural)
+		}
+		shown++
+	}
+
+	if shown == 0 {
+		switch {
+		case h.Zero != "":
+			return h.Zero
+		case h.Relative:
+			return "now"
+		case h.Short:
+			return "0s"
+		default:
+			return "0 seconds"
+		}
+	}
+
+	if !h.Relative {
+		if d.Negative {
+			return "-" + sb.String()
+		}
+		return sb.String()
+	}
+	if d.Negative {
+		return sb.String() + " ago"
+	}
+	return "in " + sb.String()
+}
+
+// Humanise formats the TimeDiff using a Humaniser with a Precision of 2, for
+// example "3 years, 2 months".
+func (d TimeDiff) Humanise() string {
+	return Humaniser{Precision: 2}.Format(d)
+}
@@ -0,0 +1,143 @@
+package times
+
+import "strings"
+
+// Locale provides the names and composite formats used by Strftime and
+// Strptime. A nil *Locale behaves like LocaleEnglish.
+//
+// Days are indexed by time.Weekday, so Days[0] is Sunday. Months are indexed
+// from zero, so Months[0] is January.
+type Locale struct {
+	Name        string
+	Months      [12]string
+	ShortMonths [12]string
+	Days        [7]string
+	ShortDays   [7]string
+	AM, PM      string
+
+	// Strftime patterns for %c, %x and %X. They must not contain these
+	// directives themselves.
+	DateTimeFormat string
+	DateFormat     string
+	TimeFormat     string
+}
+
+var LocaleEnglish = &Locale{
+	Name: "en",
+	Months: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	ShortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Days:           [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortDays:      [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %b %e %H:%M:%S %Y",
+	DateFormat:     "%m/%d/%y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+var LocaleFrench = &Locale{
+	Name: "fr",
+	Months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	Days:           [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortDays:      [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %d %b %Y %H:%M:%S",
+	DateFormat:     "%d/%m/%Y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+var LocaleGerman = &Locale{
+	Name: "de",
+	Months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+		"Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	Days:           [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortDays:      [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %d %b %Y %H:%M:%S",
+	DateFormat:     "%d.%m.%Y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+var LocaleSpanish = &Locale{
+	Name: "es",
+	Months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ShortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun",
+		"jul", "ago", "sep", "oct", "nov", "dic"},
+	Days:           [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	ShortDays:      [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %d %b %Y %H:%M:%S",
+	DateFormat:     "%d/%m/%Y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+var LocaleItalian = &Locale{
+	Name: "it",
+	Months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+		"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	ShortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu",
+		"lug", "ago", "set", "ott", "nov", "dic"},
+	Days:           [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	ShortDays:      [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %d %b %Y %H:%M:%S",
+	DateFormat:     "%d/%m/%Y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+var LocaleDutch = &Locale{
+	Name: "nl",
+	Months: [12]string{"januari", "februari", "maart", "april", "mei", "juni",
+		"juli", "augustus", "september", "oktober", "november", "december"},
+	ShortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun",
+		"jul", "aug", "sep", "okt", "nov", "dec"},
+	Days:           [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+	ShortDays:      [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	AM:             "AM",
+	PM:             "PM",
+	DateTimeFormat: "%a %d %b %Y %H:%M:%S",
+	DateFormat:     "%d-%m-%Y",
+	TimeFormat:     "%H:%M:%S",
+}
+
+// Locales contains the built-in Locales, keyed by Locale.Name. Add to it
+// before use if LookupLocale should find your own Locales.
+var Locales = map[string]*Locale{
+	LocaleEnglish.Name: LocaleEnglish,
+	LocaleFrench.Name:  LocaleFrench,
+	LocaleGerman.Name:  LocaleGerman,
+	LocaleSpanish.Name: LocaleSpanish,
+	LocaleItalian.Name: LocaleItalian,
+	LocaleDutch.Name:   LocaleDutch,
+}
+
+// LookupLocale finds a Locale in Locales by its language, ignoring case and
+// any region or encoding, so "fr", "fr-CA" and "fr_FR.UTF-8" all find
+// LocaleFrench.
+func LookupLocale(tag string) (*Locale, bool) {
+	tag = strings.ToLower(tag)
+	if idx := strings.IndexAny(tag, "-_."); idx >= 0 {
+		tag = tag[:idx]
+	}
+	l, ok := Locales[tag]
+	return l, ok
+}
+
+func (l *Locale) orDefault() *Locale {
+	if l == nil {
+		return LocaleEnglish
+	}
+	return l
+}
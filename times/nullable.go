@@ -0,0 +1,401 @@
+package times
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+)
+
+// The Null types wrap each of the codecs in this package so they can be
+// absent. A Null type is Valid if it was decoded from a non-empty value. It
+// marshals to JSON 'null', empty text and SQL NULL if it is not Valid, and
+// accepts JSON 'null' and '""', empty text, and SQL NULL or empty strings when
+// decoding.
+
+type NullRFC3339 struct {
+	RFC3339
+	Valid bool
+}
+
+func (n NullRFC3339) MarshalJSON() ([]byte, error) { return nullMarshalJSON(n.Valid, n.RFC3339) }
+func (n NullRFC3339) MarshalText() ([]byte, error) { return nullMarshalText(n.Valid, n.RFC3339) }
+func (n NullRFC3339) Value() (driver.Value, error) { return nullValue(n.Valid, n.RFC3339) }
+
+func (n *NullRFC3339) UnmarshalJSON(b []byte) error {
+	*n = NullRFC3339{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.RFC3339.UnmarshalJSON(b) })
+}
+
+func (n *NullRFC3339) UnmarshalText(b []byte) error {
+	*n = NullRFC3339{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.RFC3339.UnmarshalText(b) })
+}
+
+func (n *NullRFC3339) Scan(src interface{}) error {
+	*n = NullRFC3339{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.RFC3339.Scan(src) })
+}
+
+type NullDurationString struct {
+	DurationString
+	Valid bool
+}
+
+func (n NullDurationString) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.DurationString)
+}
+func (n NullDurationString) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.DurationString)
+}
+func (n NullDurationString) Value() (driver.Value, error) {
+	return nullValue(n.Valid, n.DurationString)
+}
+
+func (n *NullDurationString) UnmarshalJSON(b []byte) error {
+	*n = NullDurationString{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.DurationString.UnmarshalJSON(b) })
+}
+
+func (n *NullDurationString) UnmarshalText(b []byte) error {
+	*n = NullDurationString{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.DurationString.UnmarshalText(b) })
+}
+
+func (n *NullDurationString) Scan(src interface{}) error {
+	*n = NullDurationString{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.DurationString.Scan(src) })
+}
+
+type NullDurationSecInt64 struct {
+	DurationSecInt64
+	Valid bool
+}
+
+func (n NullDurationSecInt64) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.DurationSecInt64)
+}
+func (n NullDurationSecInt64) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.DurationSecInt64)
+}
+func (n NullDurationSecInt64) Value() (driver.Value, error) {
+	return nullValue(n.Valid, n.DurationSecInt64)
+}
+
+func (n *NullDurationSecInt64) UnmarshalJSON(b []byte) error {
+	*n = NullDurationSecInt64{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.DurationSecInt64.UnmarshalJSON(b) })
+}
+
+func (n *NullDurationSecInt64) UnmarshalText(b []byte) error {
+	*n = NullDurationSecInt64{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.DurationSecInt64.UnmarshalText(b) })
+}
+
+func (n *NullDurationSecInt64) Scan(src interface{}) error {
+	*n = NullDurationSecInt64{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.DurationSecInt64.Scan(src) })
+}
+
+type NullDurationMsecInt64 struct {
+	DurationMsecInt64
+	Valid bool
+}
+
+func (n NullDurationMsecInt64) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.DurationMsecInt64)
+}
+func (n NullDurationMsecInt64) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.DurationMsecInt64)
+}
+func (n NullDurationMsecInt64) Value() (driver.Value, error) {
+	return nullValue(n.Valid, n.DurationMsecInt64)
+}
+
+func (n *NullDurationMsecInt64) UnmarshalJSON(b []byte) error {
+	*n = NullDurationMsecInt64{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.DurationMsecInt64.UnmarshalJSON(b) })
+}
+
+func (n *NullDurationMsecInt64) UnmarshalText(b []byte) error {
+	*n = NullDurationMsecInt64{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.DurationMsecInt64.UnmarshalText(b) })
+}
+
+func (n *NullDurationMsecInt64) Scan(src interface{}) error {
+	*n = NullDurationMsecInt64{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.DurationMsecInt64.Scan(src) })
+}
+
+type NullDurationSecFloat struct {
+	DurationSecFloat
+	Valid bool
+}
+
+func (n NullDurationSecFloat) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.DurationSecFloat)
+}
+func (n NullDurationSecFloat) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.DurationSecFloat)
+}
+func (n NullDurationSecFloat) Value() (driver.Value, error) {
+	return nullValue(n.Valid, n.DurationSecFloat)
+}
+
+func (n *NullDurationSecFloat) UnmarshalJSON(b []byte) error {
+	*n = NullDurationSecFloat{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.DurationSecFloat.UnmarshalJSON(b) })
+}
+
+func (n *NullDurationSecFloat) UnmarshalText(b []byte) error {
+	*n = NullDurationSecFloat{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.DurationSecFloat.UnmarshalText(b) })
+}
+
+func (n *NullDurationSecFloat) Scan(src interface{}) error {
+	*n = NullDurationSecFloat{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.DurationSecFloat.Scan(src) })
+}
+
+type NullDurationMsecFloat struct {
+	DurationMsecFloat
+	Valid bool
+}
+
+func (n NullDurationMsecFloat) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.DurationMsecFloat)
+}
+func (n NullDurationMsecFloat) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.DurationMsecFloat)
+}
+func (n NullDurationMsecFloat) Value() (driver.Value, error) {
+	return nullValue(n.Valid, n.DurationMsecFloat)
+}
+
+func (n *NullDurationMsecFloat) UnmarshalJSON(b []byte) error {
+	*n = NullDurationMsecFloat{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.DurationMsecFloat.UnmarshalJSON(b) })
+}
+
+func (n *NullDurationMsecFloat) UnmarshalText(b []byte) error {
+	*n = NullDurationMsecFloat{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.DurationMsecFloat.UnmarshalText(b) })
+}
+
+func (n *NullDurationMsecFloat) Scan(src interface{}) error {
+	*n = NullDurationMsecFloat{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.DurationMsecFloat.Scan(src) })
+}
+
+type NullUnixSecInt struct {
+	UnixSecInt
+	Valid bool
+}
+
+func (n NullUnixSecInt) MarshalJSON() ([]byte, error) { return nullMarshalJSON(n.Valid, n.UnixSecInt) }
+func (n NullUnixSecInt) MarshalText() ([]byte, error) { return nullMarshalText(n.Valid, n.UnixSecInt) }
+func (n NullUnixSecInt) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixSecInt) }
+
+func (n *NullUnixSecInt) UnmarshalJSON(b []byte) error {
+	*n = NullUnixSecInt{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixSecInt.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixSecInt) UnmarshalText(b []byte) error {
+	*n = NullUnixSecInt{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixSecInt.UnmarshalText(b) })
+}
+
+func (n *NullUnixSecInt) Scan(src interface{}) error {
+	*n = NullUnixSecInt{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixSecInt.Scan(src) })
+}
+
+type NullUnixMsecInt struct {
+	UnixMsecInt
+	Valid bool
+}
+
+func (n NullUnixMsecInt) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.UnixMsecInt)
+}
+func (n NullUnixMsecInt) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.UnixMsecInt)
+}
+func (n NullUnixMsecInt) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixMsecInt) }
+
+func (n *NullUnixMsecInt) UnmarshalJSON(b []byte) error {
+	*n = NullUnixMsecInt{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixMsecInt.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixMsecInt) UnmarshalText(b []byte) error {
+	*n = NullUnixMsecInt{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixMsecInt.UnmarshalText(b) })
+}
+
+func (n *NullUnixMsecInt) Scan(src interface{}) error {
+	*n = NullUnixMsecInt{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixMsecInt.Scan(src) })
+}
+
+type NullUnixUsecInt struct {
+	UnixUsecInt
+	Valid bool
+}
+
+func (n NullUnixUsecInt) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.UnixUsecInt)
+}
+func (n NullUnixUsecInt) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.UnixUsecInt)
+}
+func (n NullUnixUsecInt) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixUsecInt) }
+
+func (n *NullUnixUsecInt) UnmarshalJSON(b []byte) error {
+	*n = NullUnixUsecInt{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixUsecInt.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixUsecInt) UnmarshalText(b []byte) error {
+	*n = NullUnixUsecInt{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixUsecInt.UnmarshalText(b) })
+}
+
+func (n *NullUnixUsecInt) Scan(src interface{}) error {
+	*n = NullUnixUsecInt{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixUsecInt.Scan(src) })
+}
+
+type NullUnixNsecInt struct {
+	UnixNsecInt
+	Valid bool
+}
+
+func (n NullUnixNsecInt) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.UnixNsecInt)
+}
+func (n NullUnixNsecInt) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.UnixNsecInt)
+}
+func (n NullUnixNsecInt) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixNsecInt) }
+
+func (n *NullUnixNsecInt) UnmarshalJSON(b []byte) error {
+	*n = NullUnixNsecInt{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixNsecInt.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixNsecInt) UnmarshalText(b []byte) error {
+	*n = NullUnixNsecInt{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixNsecInt.UnmarshalText(b) })
+}
+
+func (n *NullUnixNsecInt) Scan(src interface{}) error {
+	*n = NullUnixNsecInt{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixNsecInt.Scan(src) })
+}
+
+type NullUnixSecFloat struct {
+	UnixSecFloat
+	Valid bool
+}
+
+func (n NullUnixSecFloat) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.UnixSecFloat)
+}
+func (n NullUnixSecFloat) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.UnixSecFloat)
+}
+func (n NullUnixSecFloat) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixSecFloat) }
+
+func (n *NullUnixSecFloat) UnmarshalJSON(b []byte) error {
+	*n = NullUnixSecFloat{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixSecFloat.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixSecFloat) UnmarshalText(b []byte) error {
+	*n = NullUnixSecFloat{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixSecFloat.UnmarshalText(b) })
+}
+
+func (n *NullUnixSecFloat) Scan(src interface{}) error {
+	*n = NullUnixSecFloat{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixSecFloat.Scan(src) })
+}
+
+type NullUnixMsecFloat struct {
+	UnixMsecFloat
+	Valid bool
+}
+
+func (n NullUnixMsecFloat) MarshalJSON() ([]byte, error) {
+	return nullMarshalJSON(n.Valid, n.UnixMsecFloat)
+}
+func (n NullUnixMsecFloat) MarshalText() ([]byte, error) {
+	return nullMarshalText(n.Valid, n.UnixMsecFloat)
+}
+func (n NullUnixMsecFloat) Value() (driver.Value, error) { return nullValue(n.Valid, n.UnixMsecFloat) }
+
+func (n *NullUnixMsecFloat) UnmarshalJSON(b []byte) error {
+	*n = NullUnixMsecFloat{}
+	return nullUnmarshal(isNullJSON(b), &n.Valid, func() error { return n.UnixMsecFloat.UnmarshalJSON(b) })
+}
+
+func (n *NullUnixMsecFloat) UnmarshalText(b []byte) error {
+	*n = NullUnixMsecFloat{}
+	return nullUnmarshal(len(b) == 0, &n.Valid, func() error { return n.UnixMsecFloat.UnmarshalText(b) })
+}
+
+func (n *NullUnixMsecFloat) Scan(src interface{}) error {
+	*n = NullUnixMsecFloat{}
+	return nullUnmarshal(isNullSrc(src), &n.Valid, func() error { return n.UnixMsecFloat.Scan(src) })
+}
+
+func nullMarshalJSON(valid bool, m json.Marshaler) ([]byte, error) {
+	if !valid {
+		return []byte("null"), nil
+	}
+	return m.MarshalJSON()
+}
+
+func nullMarshalText(valid bool, m encoding.TextMarshaler) ([]byte, error) {
+	if !valid {
+		return []byte{}, nil
+	}
+	return m.MarshalText()
+}
+
+func nullValue(valid bool, v driver.Valuer) (driver.Value, error) {
+	if !valid {
+		return nil, nil
+	}
+	return v.Value()
+}
+
+func nullUnmarshal(isNull bool, valid *bool, unmarshal func() error) error {
+	if isNull {
+		return nil
+	}
+	if err := unmarshal(); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
+
+func isNullJSON(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return string(b) == "null" || string(b) == `""`
+}
+
+func isNullSrc(src interface{}) bool {
+	switch src := src.(type) {
+	case nil:
+		return true
+	case []byte:
+		return len(src) == 0
+	case string:
+		return src == ""
+	}
+	return false
+}
@@ -0,0 +1,614 @@
+package times
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strftime formats t using a POSIX strftime pattern and LocaleEnglish names.
+// See Locale.Strftime for the supported directives.
+func Strftime(t time.Time, pattern string) string {
+	return LocaleEnglish.Strftime(t, pattern)
+}
+
+// Strptime parses value using a POSIX strftime pattern and LocaleEnglish
+// names. Times without a zone are returned in UTC. See Locale.StrptimeIn.
+func Strptime(pattern, value string) (time.Time, error) {
+	return LocaleEnglish.StrptimeIn(pattern, value, time.UTC)
+}
+
+// StrptimeIn is like Strptime, but times without a zone are returned in loc.
+func StrptimeIn(pattern, value string, loc *time.Location) (time.Time, error) {
+	return LocaleEnglish.StrptimeIn(pattern, value, loc)
+}
+
+// StrftimeLayout formats times with a strftime Pattern, using the names from
+// Locale. It can be used as an interval.Layout.
+type StrftimeLayout struct {
+	Pattern string
+	Locale  *Locale
+}
+
+func (sl StrftimeLayout) Format(t time.Time) string {
+	return sl.Locale.Strftime(t, sl.Pattern)
+}
+
+// ValidateStrftime returns an error if pattern contains a directive that
+// Strftime does not support. Strftime itself copies unknown directives to the
+// output unchanged, so use this to check patterns that come from config.
+func ValidateStrftime(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(pattern) && pattern[i] == '-' {
+			i++
+		}
+		if i >= len(pattern) {
+			return fmt.Errorf("times: strftime pattern %q ends with an incomplete directive", pattern)
+		}
+		if !strings.ContainsRune(strftimeDirectives, rune(pattern[i])) {
+			return fmt.Errorf("times: strftime pattern %q contains unknown directive '%%%c'", pattern, pattern[i])
+		}
+	}
+	return nil
+}
+
+const strftimeDirectives = "aAbBcCdDeFfgGhHIjklmMnpPrRsStTuUVwWxXyYzZ%"
+
+// strftimeComposite returns the pattern that a composite directive such as
+// %F expands to.
+func (l *Locale) strftimeComposite(d byte) (pattern string, ok bool) {
+	switch d {
+	case 'c':
+		return l.DateTimeFormat, true
+	case 'x':
+		return l.DateFormat, true
+	case 'X':
+		return l.TimeFormat, true
+	case 'D':
+		return "%m/%d/%y", true
+	case 'F':
+		return "%Y-%m-%d", true
+	case 'T':
+		return "%H:%M:%S", true
+	case 'R':
+		return "%H:%M", true
+	case 'r':
+		return "%I:%M:%S %p", true
+	}
+	return "", false
+}
+
+// Strftime formats t using a POSIX strftime pattern. The supported directives
+// are:
+//
+//	%a  abbreviated weekday name     %A  full weekday name
+//	%b  abbreviated month name (%h)  %B  full month name
+//	%c  locale date and time         %x  locale date    %X  locale time
+//	%C  century (2 digits)           %y  year without century (00-99)
+//	%Y  year                         %G  ISO 8601 week-numbering year
+//	%g  %G without century           %V  ISO 8601 week (01-53)
+//	%m  month (01-12)                %d  day of month (01-31)
+//	%e  day of month, space padded   %j  day of year (001-366)
+//	%H  hour (00-23)                 %k  hour, space padded
+//	%I  hour (01-12)                 %l  hour (1-12), space padded
+//	%M  minute (00-59)               %S  second (00-60)
+//	%f  microseconds (000000-999999) %s  seconds since the Unix epoch
+//	%p  locale AM or PM              %P  %p in lower case
+//	%u  weekday (1-7, Monday is 1)   %w  weekday (0-6, Sunday is 0)
+//	%U  week of year, weeks start on Sunday (00-53)
+//	%W  week of year, weeks start on Monday (00-53)
+//	%z  zone offset (+hhmm)          %Z  zone abbreviation
+//	%D  %m/%d/%y    %F  %Y-%m-%d     %T  %H:%M:%S
+//	%R  %H:%M       %r  %I:%M:%S %p
+//	%n  newline     %t  tab          %%  literal '%'
+//
+// A '-' between the '%' and the directive suppresses padding, for example
+// "%-d" formats the 5th as "5". Unknown directives are copied to the output
+// unchanged; see ValidateStrftime.
+//
+func (l *Locale) Strftime(t time.Time, pattern string) string {
+	l = l.orDefault()
+	return string(l.appendStrftime(make([]byte, 0, len(pattern)+16), t, pattern))
+}
+
+func (l *Locale) appendStrftime(buf []byte, t time.Time, pattern string) []byte {
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' || i+1 >= len(pattern) {
+			buf = append(buf, c)
+			continue
+		}
+
+		i++
+		pad := true
+		if pattern[i] == '-' && i+1 < len(pattern) {
+			pad = false
+			i++
+		}
+		d := pattern[i]
+
+		if sub, ok := l.strftimeComposite(d); ok {
+			buf = l.appendStrftime(buf, t, sub)
+			continue
+		}
+
+		switch d {
+		case 'a':
+			buf = append(buf, l.ShortDays[t.Weekday()]...)
+		case 'A':
+			buf = append(buf, l.Days[t.Weekday()]...)
+		case 'b', 'h':
+			buf = append(buf, l.ShortMonths[t.Month()-1]...)
+		case 'B':
+			buf = append(buf, l.Months[t.Month()-1]...)
+		case 'C':
+			buf = appendPadded(buf, floorDiv(t.Year(), 100), 2, '0', pad)
+		case 'y':
+			buf = appendPadded(buf, mod(t.Year(), 100), 2, '0', pad)
+		case 'Y':
+			buf = appendPadded(buf, t.Year(), 4, '0', pad)
+		case 'G':
+			y, _ := t.ISOWeek()
+			buf = appendPadded(buf, y, 4, '0', pad)
+		case 'g':
+			y, _ := t.ISOWeek()
+			buf = appendPadded(buf, mod(y, 100), 2, '0', pad)
+		case 'V':
+			_, w := t.ISOWeek()
+			buf = appendPadded(buf, w, 2, '0', pad)
+		case 'm':
+			buf = appendPadded(buf, int(t.Month()), 2, '0', pad)
+		case 'd':
+			buf = appendPadded(buf, t.Day(), 2, '0', pad)
+		case 'e':
+			buf = appendPadded(buf, t.Day(), 2, ' ', pad)
+		case 'j':
+			buf = appendPadded(buf, t.YearDay(), 3, '0', pad)
+		case 'H':
+			buf = appendPadded(buf, t.Hour(), 2, '0', pad)
+		case 'k':
+			buf = appendPadded(buf, t.Hour(), 2, ' ', pad)
+		case 'I':
+			buf = appendPadded(buf, hour12(t.Hour()), 2, '0', pad)
+		case 'l':
+			buf = appendPadded(buf, hour12(t.Hour()), 2, ' ', pad)
+		case 'M':
+			buf = appendPadded(buf, t.Minute(), 2, '0', pad)
+		case 'S':
+			buf = appendPadded(buf, t.Second(), 2, '0', pad)
+		case 'f':
+			buf = appendPadded(buf, t.Nanosecond()/1000, 6, '0', pad)
+		case 's':
+			buf = strconv.AppendInt(buf, t.Unix(), 10)
+		case 'p':
+			buf = append(buf, l.ampm(t.Hour())...)
+		case 'P':
+			buf = append(buf, strings.ToLower(l.ampm(t.Hour()))...)
+		case 'u':
+			wd := int(t.Weekday())
+			if wd == 0 {
+				wd = 7
+			}
+			buf = strconv.AppendInt(buf, int64(wd), 10)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'U':
+			buf = appendPadded(buf, (t.YearDay()+6-int(t.Weekday()))/7, 2, '0', pad)
+		case 'W':
+			buf = appendPadded(buf, (t.YearDay()+6-(int(t.Weekday())+6)%7)/7, 2, '0', pad)
+		case 'z':
+			buf = append(buf, t.Format("-0700")...)
+		case 'Z':
+			buf = append(buf, t.Format("MST")...)
+		case 'n':
+			buf = append(buf, '\n')
+		case 't':
+			buf = append(buf, '\t')
+		case '%':
+			buf = append(buf, '%')
+		default:
+			buf = append(buf, '%')
+			if !pad {
+				buf = append(buf, '-')
+			}
+			buf = append(buf, d)
+		}
+	}
+	return buf
+}
+
+func (l *Locale) ampm(hour int) string {
+	if hour < 12 {
+		return l.AM
+	}
+	return l.PM
+}
+
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func mod(n, d int) int {
+	return n - floorDiv(n, d)*d
+}
+
+func appendPadded(buf []byte, n int, width int, padc byte, pad bool) []byte {
+	if n < 0 {
+		buf = append(buf, '-')
+		n = -n
+		width--
+	}
+	if pad {
+		digits := 1
+		for x := n; x >= 10; x /= 10 {
+			digits++
+		}
+		for ; digits < width; digits++ {
+			buf = append(buf, padc)
+		}
+	}
+	return strconv.AppendInt(buf, int64(n), 10)
+}
+
+// StrptimeIn parses value using a POSIX strftime pattern and the names in l.
+// It is the inverse of Strftime and supports the same directives except %U,
+// %W, %V, %G and %g, which return an error.
+//
+// Whitespace in the pattern matches any amount of whitespace (including none)
+// in value, and numeric fields may be preceded by spaces. Names are matched
+// without regard to case. %a, %A, %u and %w are checked but otherwise
+// ignored. %Z accepts any alphabetic zone name but only "UTC", "GMT" and "Z"
+// set the zone. If %j is given without a month and day, the date is taken
+// from the day of the year. A leap second (%S of 60) is moved to the start of
+// the next minute, as time.Date does. Without %p, %I is taken as a 24-hour
+// hour, so "12" is noon.
+//
+// As with time.Parse, fields that are not in the pattern default to the
+// start of year zero, and times without a zone (%z, %Z or %s) are returned in
+// loc. If loc is nil, UTC is used.
+//
+func (l *Locale) StrptimeIn(pattern, value string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	sp := strptimeParser{
+		locale: l.orDefault(), value: value,
+		month: -1, day: -1, yday: -1, yy: -1, century: -1, hour12: -1, pm: -1,
+	}
+	if err := sp.parse(pattern); err != nil {
+		return time.Time{}, fmt.Errorf("times: cannot parse %q as %q: %w", value, pattern, err)
+	}
+	if sp.pos != len(value) {
+		return time.Time{}, fmt.Errorf("times: cannot parse %q as %q: unexpected %q", value, pattern, value[sp.pos:])
+	}
+	t, err := sp.time(loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("times: cannot parse %q as %q: %w", value, pattern, err)
+	}
+	return t, nil
+}
+
+type strptimeParser struct {
+	locale *Locale
+	value  string
+	pos    int
+
+	year, month, day, yday int
+	yy, century            int
+	hour, hour12, pm       int
+	minute, second, nsec   int
+	hasYear                bool
+	zone                   *time.Location
+	unix                   *int64
+}
+
+func (sp *strptimeParser) parse(pattern string) (err error) {
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if isStrptimeSpace(c) {
+			sp.skipSpace()
+			continue
+		}
+		if c != '%' {
+			if sp.pos >= len(sp.value) || sp.value[sp.pos] != c {
+				return fmt.Errorf("expected %q at position %d", c, sp.pos)
+			}
+			sp.pos++
+			continue
+		}
+
+		i++
+		if i < len(pattern) && pattern[i] == '-' {
+			i++
+		}
+		if i >= len(pattern) {
+			return fmt.Errorf("pattern ends with an incomplete directive")
+		}
+		d := pattern[i]
+
+		if sub, ok := sp.locale.strftimeComposite(d); ok {
+			if err := sp.parse(sub); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch d {
+		case 'Y':
+			sp.year, err = sp.signed(4)
+			sp.hasYear = true
+		case 'C':
+			sp.century, err = sp.number(d, 2, 0, 99)
+		case 'y':
+			sp.yy, err = sp.number(d, 2, 0, 99)
+		case 'm':
+			sp.month, err = sp.number(d, 2, 1, 12)
+		case 'd', 'e':
+			sp.day, err = sp.number(d, 2, 1, 31)
+		case 'j':
+			sp.yday, err = sp.number(d, 3, 1, 366)
+		case 'H', 'k':
+			sp.hour, err = sp.number(d, 2, 0, 23)
+		case 'I', 'l':
+			sp.hour12, err = sp.number(d, 2, 1, 12)
+		case 'M':
+			sp.minute, err = sp.number(d, 2, 0, 59)
+		case 'S':
+			sp.second, err = sp.number(d, 2, 0, 60)
+		case 'f':
+			err = sp.fraction()
+		case 'u':
+			_, err = sp.number(d, 1, 1, 7)
+		case 'w':
+			_, err = sp.number(d, 1, 0, 6)
+		case 'b', 'h', 'B':
+			var m int
+			m, err = sp.name(d, sp.locale.Months[:], sp.locale.ShortMonths[:])
+			sp.month = m + 1
+		case 'a', 'A':
+			_, err = sp.name(d, sp.locale.Days[:], sp.locale.ShortDays[:])
+		case 'p', 'P':
+			sp.pm, err = sp.name(d, []string{sp.locale.AM, sp.locale.PM})
+		case 's':
+			var n int64
+			n, err = sp.int64()
+			sp.unix = &n
+		case 'z':
+			err = sp.offset()
+		case 'Z':
+			err = sp.zoneName()
+		case 'n', 't':
+			sp.skipSpace()
+		case '%':
+			if sp.pos >= len(sp.value) || sp.value[sp.pos] != '%' {
+				return fmt.Errorf("expected '%%' at position %d", sp.pos)
+			}
+			sp.pos++
+		default:
+			return fmt.Errorf("unsupported directive '%%%c'", d)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sp *strptimeParser) time(loc *time.Location) (time.Time, error) {
+	if sp.zone != nil {
+		loc = sp.zone
+	}
+	if sp.unix != nil {
+		return time.Unix(*sp.unix, 0).In(loc), nil
+	}
+
+	year := sp.year
+	if !sp.hasYear && sp.yy >= 0 {
+		if sp.century >= 0 {
+			year = sp.century*100 + sp.yy
+		} else if sp.yy < 69 {
+			year = 2000 + sp.yy
+		} else {
+			year = 1900 + sp.yy
+		}
+	} else if !sp.hasYear && sp.century >= 0 {
+		year = sp.century * 100
+	}
+
+	hour := sp.hour
+	if sp.hour12 >= 0 && sp.pm >= 0 {
+		hour = sp.hour12 % 12
+		if sp.pm == 1 {
+			hour += 12
+		}
+	} else if sp.hour12 >= 0 {
+		hour = sp.hour12
+	}
+
+	if sp.yday >= 0 && sp.month < 0 && sp.day < 0 {
+		if time.Date(year, 1, sp.yday, 0, 0, 0, 0, time.UTC).Year() != year {
+			return time.Time{}, fmt.Errorf("day of year %d out of range", sp.yday)
+		}
+		return time.Date(year, 1, sp.yday, hour, sp.minute, sp.second, sp.nsec, loc), nil
+	}
+
+	month, day := sp.month, sp.day
+	if month < 0 {
+		month = 1
+	}
+	if day < 0 {
+		day = 1
+	}
+	// The date is checked on its own, as a leap second can move the time into
+	// the next day:
+	if time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Day() != day {
+		return time.Time{}, fmt.Errorf("day %d out of range for %s", day, time.Month(month))
+	}
+	return time.Date(year, time.Month(month), day, hour, sp.minute, sp.second, sp.nsec, loc), nil
+}
+
+func (sp *strptimeParser) skipSpace() {
+	for sp.pos < len(sp.value) && isStrptimeSpace(sp.value[sp.pos]) {
+		sp.pos++
+	}
+}
+
+func (sp *strptimeParser) digits(max int) (n int, ok bool) {
+	start := sp.pos
+	for sp.pos < len(sp.value) && sp.pos-start < max && isDigit(sp.value[sp.pos]) {
+		n = n*10 + int(sp.value[sp.pos]-'0')
+		sp.pos++
+	}
+	return n, sp.pos > start
+}
+
+func (sp *strptimeParser) number(d byte, width int, min, max int) (int, error) {
+	sp.skipSpace()
+	start := sp.pos
+	n, ok := sp.digits(width)
+	if !ok {
+		return 0, fmt.Errorf("expected number for '%%%c' at position %d", d, start)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d for '%%%c' out of range", n, d)
+	}
+	return n, nil
+}
+
+func (sp *strptimeParser) signed(width int) (int, error) {
+	sp.skipSpace()
+	neg := sp.pos < len(sp.value) && sp.value[sp.pos] == '-'
+	if neg || (sp.pos < len(sp.value) && sp.value[sp.pos] == '+') {
+		sp.pos++
+	}
+	start := sp.pos
+	n, ok := sp.digits(width)
+	if !ok {
+		return 0, fmt.Errorf("expected year at position %d", start)
+	}
+	if neg {
+		n = -n
+	}
+	return n, nil
+}
+
+func (sp *strptimeParser) int64() (int64, error) {
+	sp.skipSpace()
+	start := sp.pos
+	if sp.pos < len(sp.value) && (sp.value[sp.pos] == '-' || sp.value[sp.pos] == '+') {
+		sp.pos++
+	}
+	for sp.pos < len(sp.value) && isDigit(sp.value[sp.pos]) {
+		sp.pos++
+	}
+	n, err := strconv.ParseInt(sp.value[start:sp.pos], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected seconds at position %d", start)
+	}
+	return n, nil
+}
+
+func (sp *strptimeParser) fraction() error {
+	start := sp.pos
+	n, ok := sp.digits(9)
+	if !ok {
+		return fmt.Errorf("expected fractional seconds at position %d", start)
+	}
+	for places := sp.pos - start; places < 9; places++ {
+		n *= 10
+	}
+	sp.nsec = n
+	return nil
+}
+
+// name matches the longest of the names in any of the lists, ignoring case,
+// and returns its index within its list.
+func (sp *strptimeParser) name(d byte, lists ...[]string) (idx int, err error) {
+	best := -1
+	var bestLen int
+	rest := sp.value[sp.pos:]
+	for _, names := range lists {
+		for i, name := range names {
+			if name == "" || len(name) <= bestLen || len(name) > len(rest) {
+				continue
+			}
+			if strings.EqualFold(rest[:len(name)], name) {
+				best, bestLen = i, len(name)
+			}
+		}
+	}
+	if best < 0 {
+		return 0, fmt.Errorf("expected name for '%%%c' at position %d", d, sp.pos)
+	}
+	sp.pos += bestLen
+	return best, nil
+}
+
+func (sp *strptimeParser) offset() error {
+	start := sp.pos
+	if sp.pos < len(sp.value) && sp.value[sp.pos] == 'Z' {
+		sp.pos++
+		sp.zone = time.UTC
+		return nil
+	}
+	if sp.pos >= len(sp.value) || (sp.value[sp.pos] != '+' && sp.value[sp.pos] != '-') {
+		return fmt.Errorf("expected zone offset at position %d", start)
+	}
+	neg := sp.value[sp.pos] == '-'
+	sp.pos++
+
+	hh, ok := sp.digits(2)
+	if !ok || sp.pos-start != 3 {
+		return fmt.Errorf("expected zone offset at position %d", start)
+	}
+	if sp.pos < len(sp.value) && sp.value[sp.pos] == ':' {
+		sp.pos++
+	}
+	mmStart := sp.pos
+	mm, ok := sp.digits(2)
+	if !ok || sp.pos-mmStart != 2 || hh > 23 || mm > 59 {
+		return fmt.Errorf("expected zone offset at position %d", start)
+	}
+
+	secs := hh*3600 + mm*60
+	if neg {
+		secs = -secs
+	}
+	sp.zone = time.FixedZone("", secs)
+	return nil
+}
+
+func (sp *strptimeParser) zoneName() error {
+	start := sp.pos
+	for sp.pos < len(sp.value) {
+		c := sp.value[sp.pos]
+		if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			break
+		}
+		sp.pos++
+	}
+	name := sp.value[start:sp.pos]
+	if name == "" {
+		return fmt.Errorf("expected zone name at position %d", start)
+	}
+	switch strings.ToUpper(name) {
+	case "UTC", "GMT", "Z":
+		sp.zone = time.UTC
+	}
+	return nil
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isStrptimeSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
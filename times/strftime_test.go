@@ -0,0 +1,135 @@
+package times
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	tm := time.Date(2024, 3, 5, 14, 7, 9, 123456789, time.FixedZone("AEDT", 11*3600))
+
+	for idx, tc := range []struct {
+		pattern string
+		locale  *Locale
+		out     string
+	}{
+		{"%Y-%m-%d %H:%M:%S", nil, "2024-03-05 14:07:09"},
+		{"%F %T.%f %z %Z", nil, "2024-03-05 14:07:09.123456 +1100 AEDT"},
+		{"%a %A %b %B %h", nil, "Tue Tuesday Mar March Mar"},
+		{"%e|%-d|%k|%I|%l|%p|%P", nil, " 5|5|14|02| 2|PM|pm"},
+		{"%j %U %W %V %G %g %u %w", nil, "065 09 10 10 2024 24 2 2"},
+		{"%C%y %D %R %r", nil, "2024 03/05/24 14:07 02:07:09 PM"},
+		{"%c", nil, "Tue Mar  5 14:07:09 2024"},
+		{"%x %X", LocaleGerman, "05.03.2024 14:07:09"},
+		{"%A %-d %B %Y", LocaleFrench, "mardi 5 mars 2024"},
+		{"%a %d %b", LocaleSpanish, "mar 05 mar"},
+		{"100%% %n%t%q %", nil, "100% \n\t%q %"},
+	} {
+		if out := tc.locale.Strftime(tm, tc.pattern); out != tc.out {
+			t.Fatal(idx, tc.pattern, out, "!=", tc.out)
+		}
+	}
+
+	if out := Strftime(time.Date(5, 1, 1, 0, 0, 0, 0, time.UTC), "%Y %U"); out != "0005 00" {
+		t.Fatal(out)
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	aest := time.FixedZone("", 10*3600)
+
+	for idx, tc := range []struct {
+		pattern string
+		locale  *Locale
+		in      string
+		out     time.Time
+	}{
+		{"%Y-%m-%d %H:%M:%S", nil, "2024-03-05 14:07:09", time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)},
+		{"%F %T.%f%z", nil, "2024-03-05 14:07:09.5+10:00", time.Date(2024, 3, 5, 14, 7, 9, 5e8, aest)},
+		{"%d %b %Y %l:%M %p", nil, "5 mar 2024  2:07 pm", time.Date(2024, 3, 5, 14, 7, 0, 0, time.UTC)},
+		{"%I%p", nil, "12AM", time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%A %e %B %Y", LocaleFrench, "Mardi 5 Mars 2024", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"%d. %B %Y", LocaleGerman, "05. März 2024", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"%Y %j", nil, "2024 366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"%D", nil, "03/05/69", time.Date(1969, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"%C%y", nil, "1905", time.Date(1905, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%s", nil, "86400", time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"%c %Z", nil, "Tue Mar  5 14:07:09 2024 GMT", time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)},
+		{"%Y%%", nil, "2024%", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%F %T", nil, "2016-12-31 23:59:60", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%I:%M", nil, "12:30", time.Date(0, 1, 1, 12, 30, 0, 0, time.UTC)},
+		{"%I:%M", nil, "01:30", time.Date(0, 1, 1, 1, 30, 0, 0, time.UTC)},
+		{"%I:%M %p", nil, "12:30 am", time.Date(0, 1, 1, 0, 30, 0, 0, time.UTC)},
+		{"%I:%M %p", nil, "12:30 pm", time.Date(0, 1, 1, 12, 30, 0, 0, time.UTC)},
+	} {
+		out, err := tc.locale.StrptimeIn(tc.pattern, tc.in, nil)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if !out.Equal(tc.out) || out.Location().String() != tc.out.Location().String() {
+			t.Fatal(idx, out, "!=", tc.out)
+		}
+	}
+
+	for idx, tc := range []struct {
+		pattern, in string
+	}{
+		{"%Y-%m-%d", "2023-02-29"},
+		{"%Y-%m-%d", "2024-13-01"},
+		{"%Y-%m-%d", "2024-01-01x"},
+		{"%Y %j", "2023 366"},
+		{"%S", "61"},
+		{"%I", "13"},
+		{"%Y-W%V", "2024-W01"},
+		{"%b", "Foo"},
+		{"%z", "+1"},
+		{"%Y %", "2024 "},
+	} {
+		if _, err := Strptime(tc.pattern, tc.in); err == nil {
+			t.Fatal(idx, tc.pattern, tc.in)
+		}
+	}
+
+	loc := time.FixedZone("X", -3600)
+	out, err := StrptimeIn("%F %R", "2024-03-05 14:07", loc)
+	if err != nil || !out.Equal(time.Date(2024, 3, 5, 14, 7, 0, 0, loc)) {
+		t.Fatal(out, err)
+	}
+}
+
+func TestStrftimeRoundTrip(t *testing.T) {
+	const pattern = "%a %d %B %Y %H:%M:%S.%f %z"
+	tm := time.Date(1999, 12, 31, 23, 59, 58, 999999000, time.FixedZone("", -(3*3600 + 30*60)))
+	for _, locale := range Locales {
+		s := locale.Strftime(tm, pattern)
+		out, err := locale.StrptimeIn(pattern, s, nil)
+		if err != nil {
+			t.Fatal(locale.Name, err)
+		}
+		if !out.Equal(tm) {
+			t.Fatal(locale.Name, s, out, "!=", tm)
+		}
+	}
+}
+
+func TestValidateStrftime(t *testing.T) {
+	if err := ValidateStrftime("%Y-%m-%d %-H %%"); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"%Q", "%Y %", "%-"} {
+		if err := ValidateStrftime(p); err == nil {
+			t.Fatal(p)
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	for _, tag := range []string{"fr", "FR", "fr-CA", "fr_FR.UTF-8"} {
+		if l, ok := LookupLocale(tag); !ok || l != LocaleFrench {
+			t.Fatal(tag)
+		}
+	}
+	if _, ok := LookupLocale("xx"); ok {
+		t.Fatal()
+	}
+}